@@ -0,0 +1,53 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrAssertionFailed = errors.New("assertion failed")
+
+// matchAssertions evaluates "@assert@" expressions against actual object.
+// Each failing assertion is reported separately, together with paths of fields it involves.
+// Identifier root refers to the whole actual JSON unless the object has a field of the same name.
+func (m *JSONMatcher) matchAssertions(s *matchState, assertions interface{}, actual map[string]interface{}, path []interface{}) []error {
+	sources := []interface{}{assertions}
+	if list, ok := assertions.([]interface{}); ok {
+		sources = list
+	}
+	env := func(name string) (interface{}, bool) {
+		if v, ok := actual[name]; ok || name != "root" {
+			return v, ok
+		}
+		return s.root, true
+	}
+	errs := []error{}
+	for _, src := range sources {
+		str, ok := src.(string)
+		if !ok {
+			errs = append(errs, NewErrGomatch(fmt.Errorf("%w: assertion must be a string", ErrInvalidPattern), path, src, nil, patternAssert))
+			continue
+		}
+		e, err := parseExpr(str)
+		if err != nil {
+			errs = append(errs, NewErrGomatch(err, path, src, nil, patternAssert))
+			continue
+		}
+		involved := map[string]interface{}{}
+		paths := []string{}
+		for _, id := range e.identifiers() {
+			if v, ok := actual[id]; ok {
+				involved[id] = v
+				paths = append(paths, s.formatPath(appendPath(path, id)))
+			}
+		}
+		ok, err = e.evalBool(env)
+		if err == nil && !ok {
+			err = fmt.Errorf("%w %q involving %q", ErrAssertionFailed, str, paths)
+		}
+		if err != nil {
+			errs = append(errs, NewErrGomatch(err, path, src, involved, patternAssert))
+		}
+	}
+	return errs
+}
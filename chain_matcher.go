@@ -1,6 +1,9 @@
 package gomatch
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var errMatcherNotFound = errors.New("none of matchers could be used")
 
@@ -32,6 +35,36 @@ func (m *ChainMatcher) Match(p, v interface{}) (bool, error) {
 	return false, errMatcherNotFound
 }
 
+// MatchContext performs value matching against given pattern like Match.
+// It passes match context to the matcher if it implements ContextValueMatcher.
+func (m *ChainMatcher) MatchContext(p, v interface{}, ctx MatchContext) (bool, error) {
+	for _, m := range m.matchers {
+		if !m.CanMatch(p) {
+			continue
+		}
+		if cm, ok := m.(ContextValueMatcher); ok {
+			return cm.MatchContext(p, v, ctx)
+		}
+		return m.Match(p, v)
+	}
+	return false, errMatcherNotFound
+}
+
+// ValidatePattern validates pattern p by the first matcher which can handle it.
+// It returns ErrUnvalidatedPattern if the matcher does not implement PatternValidator.
+func (m *ChainMatcher) ValidatePattern(p string) error {
+	for _, m := range m.matchers {
+		if !m.CanMatch(p) {
+			continue
+		}
+		if v, ok := m.(PatternValidator); ok {
+			return v.ValidatePattern(p)
+		}
+		return fmt.Errorf("%w %q", ErrUnvalidatedPattern, p)
+	}
+	return errMatcherNotFound
+}
+
 // NewChainMatcher creates ChainMatcher.
 func NewChainMatcher(matchers []ValueMatcher) *ChainMatcher {
 	return &ChainMatcher{matchers}
@@ -31,3 +31,51 @@ func TestChainMatcher(t *testing.T) {
 	assert.False(t, ok, "not expected to match bool")
 	assert.True(t, errors.Is(err, errMatcherNotFound))
 }
+
+type contextMatcherStub struct {
+	ctx MatchContext
+}
+
+func (m *contextMatcherStub) CanMatch(p interface{}) bool {
+	return p == "@context@"
+}
+
+func (m *contextMatcherStub) Match(p, v interface{}) (bool, error) {
+	return false, nil
+}
+
+func (m *contextMatcherStub) MatchContext(p, v interface{}, ctx MatchContext) (bool, error) {
+	m.ctx = ctx
+	return true, nil
+}
+
+func TestChainMatcherContext(t *testing.T) {
+	stub := &contextMatcherStub{}
+	m := NewJSONMatcher(NewChainMatcher([]ValueMatcher{NewNumberMatcher("@number@"), stub}))
+
+	ok, err := m.Match(`{"a": [1, "@context@"]}`, `{"a": [1, 2]}`)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{"a", 1}, stub.ctx.Path)
+	assert.Equal(t, map[string]interface{}{"a": []interface{}{1., 2.}}, stub.ctx.Root)
+}
+
+// constraintMatcherStub handles "@money@" with constraints without implementing PatternValidator.
+type constraintMatcherStub struct{}
+
+func (m constraintMatcherStub) CanMatch(p interface{}) bool {
+	return hasPattern(p, "@money@")
+}
+
+func (m constraintMatcherStub) Match(p, v interface{}) (bool, error) {
+	return true, nil
+}
+
+func TestChainMatcherValidatePattern(t *testing.T) {
+	m := NewChainMatcher([]ValueMatcher{NewUUIDMatcher("@uuid@"), constraintMatcherStub{}})
+
+	assert.Nil(t, m.ValidatePattern("@uuid@.version(4)"))
+	assert.True(t, errors.Is(m.ValidatePattern("@uuid@.version(v4)"), ErrInvalidPattern))
+	assert.True(t, errors.Is(m.ValidatePattern(`@money@.currency("EUR")`), ErrUnvalidatedPattern))
+	assert.True(t, errors.Is(m.ValidatePattern("@bool@"), errMatcherNotFound))
+}
@@ -0,0 +1,59 @@
+package gomatch
+
+import (
+	"errors"
+	"net/netip"
+)
+
+var ErrNotCIDR = errors.New("expected CIDR")
+
+// A CIDRMatcher matches CIDR blocks like "10.0.0.0/8".
+//
+// It supports an optional subnet constraint, the matched block must be fully contained in the subnet:
+//
+//	"@cidr@.inSubnet('10.0.0.0/8')"
+type CIDRMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *CIDRMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *CIDRMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return false, ErrNotCIDR
+	}
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return false, ErrNotCIDR
+	}
+	for _, c := range calls {
+		switch c.name {
+		case "inSubnet":
+			if err := matchInSubnet(c, prefix.Addr(), prefix.Bits()); err != nil {
+				return false, err
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *CIDRMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, validateSubnetCall)
+}
+
+// NewCIDRMatcher creates CIDRMatcher.
+func NewCIDRMatcher(pattern string) *CIDRMatcher {
+	return &CIDRMatcher{pattern}
+}
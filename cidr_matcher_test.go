@@ -0,0 +1,77 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var cidrMatcherTests = []struct {
+	desc string
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match IPv4 CIDR",
+		"@pattern@",
+		"10.0.0.0/8",
+		true,
+		nil,
+	},
+	{
+		"Should match IPv6 CIDR",
+		"@pattern@",
+		"2001:db8::/32",
+		true,
+		nil,
+	},
+	{
+		"Should not match IP without prefix length",
+		"@pattern@",
+		"10.0.0.0",
+		false,
+		ErrNotCIDR,
+	},
+	{
+		"Should not match number",
+		"@pattern@",
+		8,
+		false,
+		ErrNotCIDR,
+	},
+	{
+		"Should match CIDR contained in subnet",
+		"@pattern@.inSubnet('10.0.0.0/8')",
+		"10.1.0.0/16",
+		true,
+		nil,
+	},
+	{
+		"Should not match CIDR wider than subnet",
+		"@pattern@.inSubnet('10.0.0.0/16')",
+		"10.0.0.0/8",
+		false,
+		ErrNotInSubnet,
+	},
+}
+
+func TestCIDRMatcher(t *testing.T) {
+	for _, tt := range cidrMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewCIDRMatcher("@pattern@")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	ErrNotLatitude          = errors.New("expected latitude")
+	ErrNotLongitude         = errors.New("expected longitude")
+	ErrCoordinateNotInRange = errors.New("expected coordinate between")
+)
+
+// A CoordinateMatcher matches latitudes or longitudes given as JSON numbers in degrees.
+//
+// It supports an optional range constraint, bounds are inclusive:
+//
+//	"@latitude@.between(52.33, 52.67)"
+//	"@longitude@.between(13.08, 13.76)"
+type CoordinateMatcher struct {
+	pattern string
+	limit   float64
+	err     error
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *CoordinateMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *CoordinateMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	f, ok := v.(float64)
+	if !ok || f < -m.limit || f > m.limit {
+		return false, m.err
+	}
+	for _, c := range calls {
+		switch c.name {
+		case "between":
+			min, max, err := parseCoordinateRange(c)
+			if err != nil {
+				return false, err
+			}
+			if f < min || f > max {
+				return false, fmt.Errorf("%w %s and %s", ErrCoordinateNotInRange, c.args[0], c.args[1])
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *CoordinateMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		if c.name != "between" {
+			return errUnknownConstraint(c)
+		}
+		_, _, err := parseCoordinateRange(c)
+		return err
+	})
+}
+
+func parseCoordinateRange(c patternCall) (float64, float64, error) {
+	if len(c.args) != 2 {
+		return 0, 0, errConstraintArgs(c, 2)
+	}
+	min, errMin := strconv.ParseFloat(c.args[0], 64)
+	max, errMax := strconv.ParseFloat(c.args[1], 64)
+	if errMin != nil || errMax != nil {
+		return 0, 0, fmt.Errorf("%w: invalid coordinate range %v", ErrInvalidPattern, c.args)
+	}
+	return min, max, nil
+}
+
+// NewLatitudeMatcher creates CoordinateMatcher matching latitudes in range -90 to 90.
+func NewLatitudeMatcher(pattern string) *CoordinateMatcher {
+	return &CoordinateMatcher{pattern, 90, ErrNotLatitude}
+}
+
+// NewLongitudeMatcher creates CoordinateMatcher matching longitudes in range -180 to 180.
+func NewLongitudeMatcher(pattern string) *CoordinateMatcher {
+	return &CoordinateMatcher{pattern, 180, ErrNotLongitude}
+}
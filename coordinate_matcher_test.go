@@ -0,0 +1,154 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var coordinateMatcherTests = []struct {
+	desc string
+	m    *CoordinateMatcher
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match latitude",
+		NewLatitudeMatcher("@pattern@"),
+		52.52,
+		true,
+		nil,
+	},
+	{
+		"Should not match latitude out of range",
+		NewLatitudeMatcher("@pattern@"),
+		90.5,
+		false,
+		ErrNotLatitude,
+	},
+	{
+		"Should not match latitude given as string",
+		NewLatitudeMatcher("@pattern@"),
+		"52.52",
+		false,
+		ErrNotLatitude,
+	},
+	{
+		"Should match longitude",
+		NewLongitudeMatcher("@pattern@"),
+		-179.9,
+		true,
+		nil,
+	},
+	{
+		"Should not match longitude out of range",
+		NewLongitudeMatcher("@pattern@"),
+		180.1,
+		false,
+		ErrNotLongitude,
+	},
+}
+
+func TestCoordinateMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range coordinateMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.True(t, tt.m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := tt.m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
+
+var coordinateMatcherConstraintTests = []struct {
+	desc string
+	m    *CoordinateMatcher
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match latitude in range",
+		NewLatitudeMatcher("@pattern@"),
+		"@pattern@.between(52.33, 52.67)",
+		52.52,
+		true,
+		nil,
+	},
+	{
+		"Should match longitude at range bound",
+		NewLongitudeMatcher("@pattern@"),
+		"@pattern@.between('13.08', '13.76')",
+		13.76,
+		true,
+		nil,
+	},
+	{
+		"Should not match latitude out of range",
+		NewLatitudeMatcher("@pattern@"),
+		"@pattern@.between(52.33, 52.67)",
+		48.14,
+		false,
+		ErrCoordinateNotInRange,
+	},
+	{
+		"Should validate coordinate before range",
+		NewLongitudeMatcher("@pattern@"),
+		"@pattern@.between(-200, 200)",
+		190.0,
+		false,
+		ErrNotLongitude,
+	},
+	{
+		"Should fail on invalid range",
+		NewLatitudeMatcher("@pattern@"),
+		"@pattern@.between(north, 52.67)",
+		52.52,
+		false,
+		ErrInvalidPattern,
+	},
+	{
+		"Should fail on wrong number of arguments",
+		NewLatitudeMatcher("@pattern@"),
+		"@pattern@.between(52.33)",
+		52.52,
+		false,
+		ErrInvalidPattern,
+	},
+	{
+		"Should fail on unknown constraint",
+		NewLatitudeMatcher("@pattern@"),
+		"@pattern@.within(52.33, 52.67)",
+		52.52,
+		false,
+		ErrInvalidPattern,
+	},
+}
+
+func TestCoordinateMatcherConstraints(t *testing.T) {
+	for _, tt := range coordinateMatcherConstraintTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.True(t, tt.m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := tt.m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package gomatch
+
+import "errors"
+
+var ErrNotCountry = errors.New("expected ISO 3166 country code")
+
+// A CountryMatcher matches ISO 3166-1 alpha-2 country codes, e.g. "SK".
+//
+// Alpha-3 codes, e.g. "SVK", are expected when a constraint is used:
+//
+//	"@country@.alpha3()"
+type CountryMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *CountryMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *CountryMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	alpha3 := false
+	for _, c := range calls {
+		switch c.name {
+		case "alpha3":
+			if len(c.args) != 0 {
+				return false, errConstraintArgs(c, 0)
+			}
+			alpha3 = true
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	s, ok := v.(string)
+	if !ok {
+		return false, ErrNotCountry
+	}
+	if alpha3 {
+		if isoCountryAlpha3(s) {
+			return true, nil
+		}
+		return false, ErrNotCountry
+	}
+	if _, ok := isoCountries[s]; !ok {
+		return false, ErrNotCountry
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *CountryMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		return validateFlagCall(c, "alpha3")
+	})
+}
+
+func isoCountryAlpha3(s string) bool {
+	for _, a3 := range isoCountries {
+		if a3 == s {
+			return true
+		}
+	}
+	return false
+}
+
+// NewCountryMatcher creates CountryMatcher.
+func NewCountryMatcher(pattern string) *CountryMatcher {
+	return &CountryMatcher{pattern}
+}
@@ -0,0 +1,91 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var countryMatcherTests = []struct {
+	desc string
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match country",
+		"@pattern@",
+		"SK",
+		true,
+		nil,
+	},
+	{
+		"Should not match lowercase country",
+		"@pattern@",
+		"sk",
+		false,
+		ErrNotCountry,
+	},
+	{
+		"Should not match user assigned code",
+		"@pattern@",
+		"ZZ",
+		false,
+		ErrNotCountry,
+	},
+	{
+		"Should not match alpha-3 code by default",
+		"@pattern@",
+		"SVK",
+		false,
+		ErrNotCountry,
+	},
+	{
+		"Should match alpha-3 code",
+		"@pattern@.alpha3()",
+		"SVK",
+		true,
+		nil,
+	},
+	{
+		"Should not match alpha-2 code when alpha-3 expected",
+		"@pattern@.alpha3()",
+		"SK",
+		false,
+		ErrNotCountry,
+	},
+	{
+		"Should not match number",
+		"@pattern@",
+		703,
+		false,
+		ErrNotCountry,
+	},
+	{
+		"Should fail on alpha-3 constraint with arguments",
+		"@pattern@.alpha3(true)",
+		"SVK",
+		false,
+		ErrInvalidPattern,
+	},
+}
+
+func TestCountryMatcher(t *testing.T) {
+	for _, tt := range countryMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewCountryMatcher("@pattern@")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
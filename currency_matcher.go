@@ -0,0 +1,29 @@
+package gomatch
+
+import "errors"
+
+var ErrNotCurrency = errors.New("expected ISO 4217 currency code")
+
+// A CurrencyMatcher matches active ISO 4217 currency codes, e.g. "EUR".
+type CurrencyMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *CurrencyMatcher) CanMatch(p interface{}) bool {
+	return isPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *CurrencyMatcher) Match(p, v interface{}) (bool, error) {
+	s, ok := v.(string)
+	if !ok || !isoCurrencies[s] {
+		return false, ErrNotCurrency
+	}
+	return true, nil
+}
+
+// NewCurrencyMatcher creates CurrencyMatcher.
+func NewCurrencyMatcher(pattern string) *CurrencyMatcher {
+	return &CurrencyMatcher{pattern}
+}
@@ -0,0 +1,66 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var currencyMatcherTests = []struct {
+	desc string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match currency",
+		"EUR",
+		true,
+		nil,
+	},
+	{
+		"Should match fund code",
+		"CHE",
+		true,
+		nil,
+	},
+	{
+		"Should not match withdrawn currency",
+		"DEM",
+		false,
+		ErrNotCurrency,
+	},
+	{
+		"Should not match lowercase currency",
+		"eur",
+		false,
+		ErrNotCurrency,
+	},
+	{
+		"Should not match number",
+		978,
+		false,
+		ErrNotCurrency,
+	},
+}
+
+func TestCurrencyMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range currencyMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewCurrencyMatcher(pattern)
+			assert.True(t, m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
@@ -2,35 +2,137 @@ package gomatch
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 )
 
-var ErrNotDate = errors.New("expected date")
+var (
+	ErrNotDate       = errors.New("expected date")
+	ErrDateNotAfter  = errors.New("expected date after")
+	ErrDateNotBefore = errors.New("expected date before")
+)
+
+// ClockFn is a function type that returns current time.
+// It allows to use a fixed time in tests instead of time.Now.
+type ClockFn func() time.Time
 
-// A DateMatcher matches any string
+// A DateMatcher matches RFC 3339 dates.
+//
+// It supports optional constraints with bounds given as RFC 3339 dates or relative to the current time:
+//
+//	"@date@.after('2020-01-01T00:00:00Z')"
+//	"@date@.before('now')"
+//	"@date@.after('now-PT1H')"
 type DateMatcher struct {
 	pattern string
+	clock   ClockFn
 }
 
 // CanMatch returns true if pattern p can be handled.
 func (m *DateMatcher) CanMatch(p interface{}) bool {
-	return isPattern(p, m.pattern)
+	return hasPattern(p, m.pattern)
 }
 
 // Match performs value matching against given pattern.
 func (m *DateMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
 	value, ok := v.(string)
 	if !ok {
 		return ok, ErrNotDate
 	}
-	_, err := time.Parse(time.RFC3339, value)
+	date, err := time.Parse(time.RFC3339, value)
 	if err != nil {
 		return false, ErrNotDate
 	}
+	for _, c := range calls {
+		if len(c.args) != 1 {
+			return false, errConstraintArgs(c, 1)
+		}
+		bound, err := parseDateBound(c.args[0], m.clock)
+		if err != nil {
+			return false, err
+		}
+		switch c.name {
+		case "after":
+			if !date.After(bound) {
+				return false, fmt.Errorf("%w %s", ErrDateNotAfter, c.args[0])
+			}
+		case "before":
+			if !date.Before(bound) {
+				return false, fmt.Errorf("%w %s", ErrDateNotBefore, c.args[0])
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
 	return ok, nil
 }
 
-// NewDateMatcher creates StringMatcher.
+// ValidatePattern validates constraints of pattern p.
+func (m *DateMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		switch {
+		case c.name != "after" && c.name != "before":
+			return errUnknownConstraint(c)
+		case len(c.args) != 1:
+			return errConstraintArgs(c, 1)
+		}
+		_, err := parseDateBound(c.args[0], m.clock)
+		return err
+	})
+}
+
+// Clock sets function used to get current time, time.Now is used by default.
+func (m *DateMatcher) Clock(c ClockFn) {
+	m.clock = c
+}
+
+// Clock sets function used to get current time by value matchers of m which depend on it, e.g. DateMatcher
+// and IntervalMatcher, including matchers of chains. Matchers added later are not affected.
+func (m *JSONMatcher) Clock(c ClockFn) {
+	setClock(m.valueMatcher, c)
+}
+
+func setClock(vm ValueMatcher, c ClockFn) {
+	switch vm := vm.(type) {
+	case *ChainMatcher:
+		for _, m := range vm.matchers {
+			setClock(m, c)
+		}
+	case interface{ Clock(ClockFn) }:
+		vm.Clock(c)
+	}
+}
+
+// parseDateBound parses RFC 3339 date or "now" optionally followed by a signed duration, e.g. "now-PT1H".
+func parseDateBound(s string, clock ClockFn) (time.Time, error) {
+	rest, ok := strings.CutPrefix(s, "now")
+	if !ok {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: invalid date %q", ErrInvalidPattern, s)
+		}
+		return t, nil
+	}
+	now := clock()
+	if rest == "" {
+		return now, nil
+	}
+	d, ok := parseDuration(rest[1:], allDurationSyntaxes)
+	if !ok || (rest[0] != '+' && rest[0] != '-') {
+		return time.Time{}, fmt.Errorf("%w: invalid date %q", ErrInvalidPattern, s)
+	}
+	if rest[0] == '-' {
+		d = -d
+	}
+	return now.Add(d), nil
+}
+
+// NewDateMatcher creates DateMatcher.
 func NewDateMatcher(pattern string) *DateMatcher {
-	return &DateMatcher{pattern}
+	return &DateMatcher{pattern, time.Now}
 }
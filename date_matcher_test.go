@@ -1,7 +1,9 @@
 package gomatch
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -51,3 +53,99 @@ func TestDateMatcher(t *testing.T) {
 		})
 	}
 }
+
+var dateMatcherConstraintTests = []struct {
+	desc string
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match date after given date",
+		"@pattern@.after('2020-01-01T00:00:00Z')",
+		"2020-01-02T00:00:00Z",
+		true,
+		nil,
+	},
+	{
+		"Should not match date before given date",
+		"@pattern@.after('2020-01-01T00:00:00Z')",
+		"2019-12-31T00:00:00Z",
+		false,
+		ErrDateNotAfter,
+	},
+	{
+		"Should match date before now",
+		"@pattern@.before('now')",
+		"2024-01-14T00:00:00Z",
+		true,
+		nil,
+	},
+	{
+		"Should match date within last hour",
+		"@pattern@.after('now-PT1H').before('now+1m')",
+		"2024-01-14T23:30:00Z",
+		true,
+		nil,
+	},
+	{
+		"Should not match date older than hour",
+		"@pattern@.after('now-PT1H')",
+		"2024-01-14T22:30:00Z",
+		false,
+		ErrDateNotAfter,
+	},
+	{
+		"Should not match date in future",
+		"@pattern@.before('now')",
+		"2024-01-15T00:00:01Z",
+		false,
+		ErrDateNotBefore,
+	},
+	{
+		"Should fail on invalid bound",
+		"@pattern@.before('yesterday')",
+		"2024-01-15T00:00:00Z",
+		false,
+		ErrInvalidPattern,
+	},
+}
+
+func TestDateMatcherConstraints(t *testing.T) {
+	for _, tt := range dateMatcherConstraintTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewDateMatcher("@pattern@")
+			m.Clock(func() time.Time { return time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC) })
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
+
+func TestJSONMatcherClock(t *testing.T) {
+	m := NewJSONMatcher(NewChainMatcher([]ValueMatcher{
+		NewStringMatcher("@string@"),
+		NewChainMatcher([]ValueMatcher{NewDateMatcher("@date@")}),
+		NewIntervalMatcher("@interval@"),
+	}))
+	m.Clock(func() time.Time { return time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC) })
+
+	p := `{"created": "@date@.after('now-P1D')", "valid": "@interval@.containsNow()"}`
+	ok, err := m.Match(p, `{"created": "2024-01-14T12:00:00Z", "valid": "2024-01-01T00:00:00Z/P1M"}`)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.Match(p, `{"created": "2024-01-13T12:00:00Z", "valid": "2024-02-01T00:00:00Z/P1M"}`)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrDateNotAfter))
+	assert.True(t, errors.Is(err, ErrIntervalNotContains))
+}
@@ -0,0 +1,257 @@
+package gomatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+)
+
+const (
+	colorRed   = "\x1b[31m"
+	colorGreen = "\x1b[32m"
+	colorReset = "\x1b[0m"
+)
+
+// A DiffReporter writes a unified diff of expected JSON pattern and actual JSON of a Result.
+//
+// Both JSONs are pretty-printed. Mismatching values are marked with "-" (expected) and "+" (actual) lines,
+// the "+" line is annotated with the reason. Values matched by patterns are printed unmarked as actual values:
+//
+//	--- expected
+//	+++ actual
+//	  {
+//	    "id": 351,
+//	-   "name": "John",
+//	+   "name": "Joe",  # values are not equal
+//	  }
+type DiffReporter struct {
+	w     io.Writer
+	color bool
+}
+
+// NewDiffReporter creates DiffReporter writing to w.
+// ANSI colours are enabled when w is a terminal.
+func NewDiffReporter(w io.Writer) *DiffReporter {
+	return &DiffReporter{w, isTerminal(w)}
+}
+
+// Color enables or disables ANSI colours.
+func (r *DiffReporter) Color(color bool) {
+	r.color = color
+}
+
+// Report writes diff of the result.
+func (r *DiffReporter) Report(res *Result) error {
+	d := &diff{mismatches: res.Mismatches, format: res.pathFormat, keyMode: res.keyMode}
+	d.walk(res.expected, res.actual, true, true, nil, 0, "", true)
+	var b strings.Builder
+	b.WriteString("--- expected\n+++ actual\n")
+	for _, l := range d.lines {
+		line := fmt.Sprintf("%c %s%s", l.marker, strings.Repeat("  ", l.indent), l.text)
+		if l.note != "" {
+			line += "  # " + l.note
+		}
+		if r.color && l.marker != ' ' {
+			color := colorGreen
+			if l.marker == '-' {
+				color = colorRed
+			}
+			line = color + line + colorReset
+		}
+		b.WriteString(line + "\n")
+	}
+	_, err := io.WriteString(r.w, b.String())
+	return err
+}
+
+type diffLine struct {
+	marker byte
+	indent int
+	text   string
+	note   string
+}
+
+type diff struct {
+	mismatches []Mismatch
+	lines      []diffLine
+	format     PathFormat
+	keyMode    StringMode
+}
+
+// walk renders expected value e and actual value a located at path.
+// Flags hasE and hasA tell whether the values are present, prefix is a key of the value in its parent object.
+func (d *diff) walk(e, a interface{}, hasE, hasA bool, path []interface{}, indent int, prefix string, last bool) {
+	comma := commaUnless(last)
+	if !d.hasMismatchUnder(path) {
+		if hasA {
+			d.block(' ', a, indent, prefix, comma, "")
+		}
+		return
+	}
+	if hasE && hasA && d.descendable(path) {
+		switch ev := e.(type) {
+		case map[string]interface{}:
+			if av, ok := a.(map[string]interface{}); ok {
+				d.walkObject(ev, av, path, indent, prefix, comma)
+				return
+			}
+		case []interface{}:
+			if av, ok := a.([]interface{}); ok {
+				d.walkArray(ev, av, path, indent, prefix, comma)
+				return
+			}
+		}
+	}
+	note := d.notesUnder(path)
+	if hasE {
+		d.block('-', e, indent, prefix, comma, "")
+	}
+	if hasA {
+		d.block('+', a, indent, prefix, comma, note)
+	} else {
+		d.lines[len(d.lines)-1].note = note
+	}
+}
+
+func (d *diff) walkObject(e, a map[string]interface{}, path []interface{}, indent int, prefix, comma string) {
+	d.lines = append(d.lines, diffLine{' ', indent, prefix + "{", d.notesAt(path)})
+	// keys maps keys of the diff to keys of expected object, actual keys are paired the same way as by Match.
+	keys := map[string]string{}
+	for k := range a {
+		keys[k] = ""
+	}
+	pairs := d.keyMode.pairKeys(e, a)
+	for k := range e {
+		if ak, ok := pairs.paired[k]; ok {
+			keys[ak] = k
+		} else if !isUnbounded(k) && !isAssert(k) {
+			keys[k] = k
+		}
+	}
+	entries := []string{}
+	for _, k := range slices.Sorted(maps.Keys(keys)) {
+		if _, hasA := a[k]; hasA || d.hasMismatchUnder(appendPath(path, k)) {
+			entries = append(entries, k)
+		}
+	}
+	for i, k := range entries {
+		ev, hasE := e[keys[k]]
+		av, hasA := a[k]
+		d.walk(ev, av, hasE, hasA, appendPath(path, k), indent+1, valueOf(k)+": ", i == len(entries)-1)
+	}
+	d.lines = append(d.lines, diffLine{' ', indent, "}" + comma, ""})
+}
+
+func (d *diff) walkArray(e, a []interface{}, path []interface{}, indent int, prefix, comma string) {
+	d.lines = append(d.lines, diffLine{' ', indent, prefix + "[", d.notesAt(path)})
+	unbounded := false
+	if i := slices.IndexFunc(e, isUnbounded); i >= 0 {
+		e, unbounded = e[:i], true
+	}
+	n := max(len(e), len(a))
+	for i := 0; i < n; i++ {
+		last := i == n-1
+		p := appendPath(path, i)
+		switch {
+		case i >= len(e) && unbounded:
+			d.walk(nil, a[i], false, true, p, indent+1, "", last)
+		case i >= len(e):
+			d.block('+', a[i], indent+1, "", commaUnless(last), "")
+		case i >= len(a):
+			d.block('-', e[i], indent+1, "", commaUnless(last), "")
+		default:
+			d.walk(e[i], a[i], true, true, p, indent+1, "", last)
+		}
+	}
+	d.lines = append(d.lines, diffLine{' ', indent, "]" + comma, ""})
+}
+
+// block renders pretty-printed value v, the note is added to its first line.
+func (d *diff) block(marker byte, v interface{}, indent int, prefix, comma, note string) {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	for i, text := range lines {
+		l := diffLine{marker: marker, indent: indent, text: text}
+		if i == 0 {
+			l.text, l.note = prefix+text, note
+		}
+		if i == len(lines)-1 {
+			l.text += comma
+		}
+		d.lines = append(d.lines, l)
+	}
+}
+
+// descendable returns true if mismatches at path itself do not prevent comparing nested values.
+func (d *diff) descendable(path []interface{}) bool {
+	for _, mm := range d.mismatches {
+		if slices.Equal(mm.Path, path) && mm.Kind != KindArrayLength && mm.Kind != KindAssertion {
+			return false
+		}
+	}
+	return true
+}
+
+func (d *diff) hasMismatchUnder(path []interface{}) bool {
+	for _, mm := range d.mismatches {
+		if isPathPrefix(path, mm.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// notesAt returns reasons of mismatches located at path.
+func (d *diff) notesAt(path []interface{}) string {
+	notes := []string{}
+	for _, mm := range d.mismatches {
+		if slices.Equal(mm.Path, path) {
+			notes = append(notes, mm.Err.Error())
+		}
+	}
+	return strings.Join(notes, "; ")
+}
+
+// notesUnder returns reasons of mismatches located at path or under it, nested ones with their paths.
+func (d *diff) notesUnder(path []interface{}) string {
+	notes := []string{}
+	for _, mm := range d.mismatches {
+		switch {
+		case slices.Equal(mm.Path, path):
+			notes = append(notes, mm.Err.Error())
+		case isPathPrefix(path, mm.Path):
+			notes = append(notes, fmt.Sprintf("%s: %s", formatPath(d.format, mm.Path), mm.Err))
+		}
+	}
+	return strings.Join(notes, "; ")
+}
+
+func isPathPrefix(prefix, path []interface{}) bool {
+	return len(prefix) <= len(path) && slices.Equal(prefix, path[:len(prefix)])
+}
+
+func commaUnless(last bool) string {
+	if last {
+		return ""
+	}
+	return ","
+}
+
+// isTerminal returns true if w is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,104 @@
+package gomatch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffReporter(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	r, err := m.MatchResult(
+		`{"id": "@number@", "name": "John", "tags": ["a", "b"], "address": {"city": "@string@", "zip": "@string@"}, "items": [1, "@...@"]}`,
+		`{"id": 351, "name": "Joe", "tags": ["a"], "address": {"city": "Boston", "zip": 12345, "street": "Main"}, "items": [1, 2]}`,
+	)
+	assert.Nil(t, err)
+
+	var b strings.Builder
+	assert.Nil(t, NewDiffReporter(&b).Report(r))
+	assert.Equal(t, `--- expected
++++ actual
+  {
+    "address": {
+      "city": "Boston",
++     "street": "Main",  # unexpected key "street"
+-     "zip": "@string@"
++     "zip": 12345  # expected string
+    },
+    "id": 351,
+    "items": [
+      1,
+      2
+    ],
+-   "name": "John",
++   "name": "Joe",  # values are not equal
+    "tags": [  # arrays sizes are not equal
+      "a",
+-     "b"
+    ]
+  }
+`, b.String())
+}
+
+func TestDiffReporterStructuralMismatches(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	r, err := m.MatchResult(
+		`{"user": {"name": "John"}, "payment": "@union('type', {\"card\": {\"number\": \"@pan@\"}})@", "@assert@": "1 > 2"}`,
+		`{"user": ["John"], "payment": {"type": "card", "number": "1"}}`,
+	)
+	assert.Nil(t, err)
+
+	var b strings.Builder
+	assert.Nil(t, NewDiffReporter(&b).Report(r))
+	assert.Equal(t, `--- expected
++++ actual
+  {  # assertion failed "1 > 2" involving []
+-   "payment": "@union('type', {\"card\": {\"number\": \"@pan@\"}})@",
++   "payment": {  # .payment.number: expected card number
++     "number": "1",
++     "type": "card"
++   },
+-   "user": {
+-     "name": "John"
+-   }
++   "user": [  # types are not equal
++     "John"
++   ]
+  }
+`, b.String())
+}
+
+func TestDiffReporterKeyModes(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	m.CompareKeys(IgnoreCase)
+	r, err := m.MatchResult(`{"Name": "a", "Other": {"X": 1}, "Missing": 1}`, `{"name": "b", "other": {"x": 2}}`)
+	assert.Nil(t, err)
+
+	var b strings.Builder
+	assert.Nil(t, NewDiffReporter(&b).Report(r))
+	assert.Equal(t, `--- expected
++++ actual
+  {
+-   "Missing": 1,  # missing key "Missing"
+-   "name": "a",
++   "name": "b",  # values are not equal
+    "other": {
+-     "x": 1
++     "x": 2  # values are not equal
+    }
+  }
+`, b.String())
+}
+
+func TestDiffReporterColor(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	r, err := m.MatchResult(`{"a": 1}`, `{"a": 2}`)
+	assert.Nil(t, err)
+
+	var b strings.Builder
+	reporter := NewDiffReporter(&b)
+	reporter.Color(true)
+	assert.Nil(t, reporter.Report(r))
+	assert.Equal(t, "--- expected\n+++ actual\n  {\n\x1b[31m-   \"a\": 1\x1b[0m\n\x1b[32m+   \"a\": 2  # values are not equal\x1b[0m\n  }\n", b.String())
+}
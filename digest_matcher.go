@@ -0,0 +1,126 @@
+package gomatch
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+)
+
+var (
+	ErrNotDigest       = errors.New("expected digest")
+	ErrDigestMismatch  = errors.New("expected digest of")
+	errDigestOfContext = fmt.Errorf("%w: digestOf constraint can be used only by JSONMatcher", ErrInvalidPattern)
+)
+
+// A DigestMatcher matches hex encoded digests of a hash function, e.g. SHA-256.
+//
+// It supports an optional constraint which computes a digest of another value of the actual JSON
+// and compares it with the matched digest:
+//
+//	"@sha256@.digestOf(.path.to.content)"
+//
+// String values are hashed as they are, other values are hashed in their JSON encoding.
+// The constraint needs to know the whole document so it can be used only within JSONMatcher.
+type DigestMatcher struct {
+	pattern string
+	name    string
+	newHash func() hash.Hash
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *DigestMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *DigestMatcher) Match(p, v interface{}) (bool, error) {
+	return m.MatchContext(p, v, MatchContext{})
+}
+
+// MatchContext performs value matching against given pattern.
+// Match context is used to locate a value referenced by digestOf constraint.
+func (m *DigestMatcher) MatchContext(p, v interface{}, ctx MatchContext) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	s, ok := v.(string)
+	if !ok || len(s) != m.newHash().Size()*2 || !hexRe.MatchString(s) {
+		return false, fmt.Errorf("%w %s", ErrNotDigest, m.name)
+	}
+	for _, c := range calls {
+		switch c.name {
+		case "digestOf":
+			if len(c.args) != 1 {
+				return false, errConstraintArgs(c, 1)
+			}
+			if ctx.Root == nil {
+				return false, errDigestOfContext
+			}
+			digest, err := m.digestOf(ctx.Root, c.args[0])
+			if err != nil {
+				return false, err
+			}
+			if !strings.EqualFold(s, digest) {
+				return false, fmt.Errorf("%w %s, computed: %s", ErrDigestMismatch, c.args[0], digest)
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *DigestMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		switch {
+		case c.name != "digestOf":
+			return errUnknownConstraint(c)
+		case len(c.args) != 1:
+			return errConstraintArgs(c, 1)
+		}
+		if _, err := parsePath(c.args[0]); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidPattern, err)
+		}
+		return nil
+	})
+}
+
+func (m *DigestMatcher) digestOf(root interface{}, p string) (string, error) {
+	path, err := parsePath(p)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidPattern, err)
+	}
+	v, ok := lookupPath(root, path)
+	if !ok {
+		return "", fmt.Errorf("%w %s, value not found", ErrDigestMismatch, p)
+	}
+	content, ok := v.(string)
+	if !ok {
+		content = valueOf(v)
+	}
+	h := m.newHash()
+	h.Write([]byte(content))
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// NewSHA256Matcher creates DigestMatcher matching SHA-256 digests.
+func NewSHA256Matcher(pattern string) *DigestMatcher {
+	return &DigestMatcher{pattern, "SHA-256", sha256.New}
+}
+
+// NewSHA1Matcher creates DigestMatcher matching SHA-1 digests, e.g. git commit hashes.
+func NewSHA1Matcher(pattern string) *DigestMatcher {
+	return &DigestMatcher{pattern, "SHA-1", sha1.New}
+}
+
+// NewMD5Matcher creates DigestMatcher matching MD5 digests.
+func NewMD5Matcher(pattern string) *DigestMatcher {
+	return &DigestMatcher{pattern, "MD5", md5.New}
+}
@@ -0,0 +1,127 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var digestMatcherTests = []struct {
+	desc string
+	m    *DigestMatcher
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match SHA-256 digest",
+		NewSHA256Matcher("@pattern@"),
+		"2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+		true,
+		nil,
+	},
+	{
+		"Should not match SHA-1 digest as SHA-256",
+		NewSHA256Matcher("@pattern@"),
+		"aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d",
+		false,
+		ErrNotDigest,
+	},
+	{
+		"Should match SHA-1 digest",
+		NewSHA1Matcher("@pattern@"),
+		"aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d",
+		true,
+		nil,
+	},
+	{
+		"Should match MD5 digest",
+		NewMD5Matcher("@pattern@"),
+		"5D41402ABC4B2A76B9719D911017C592",
+		true,
+		nil,
+	},
+	{
+		"Should not match non hex digest",
+		NewMD5Matcher("@pattern@"),
+		"5d41402abc4b2a76b9719d911017c59z",
+		false,
+		ErrNotDigest,
+	},
+	{
+		"Should not match number",
+		NewMD5Matcher("@pattern@"),
+		123,
+		false,
+		ErrNotDigest,
+	},
+}
+
+func TestDigestMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range digestMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.True(t, tt.m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := tt.m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
+
+func TestDigestMatcherDigestOf(t *testing.T) {
+	m := NewJSONMatcher(NewChainMatcher([]ValueMatcher{NewSHA256Matcher("@sha256@"), NewMD5Matcher("@md5@")}))
+	v := `
+	{
+		"content": "hello",
+		"meta": {
+			"sha256": "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824",
+			"md5": "5d41402abc4b2a76b9719d911017c592",
+			"tags": ["a"],
+			"tagsMd5": "4c96bbc0e2390918dd50ef8e7eaff6e2"
+		}
+	}
+	`
+
+	ok, err := m.Match(`
+	{
+		"content": "hello",
+		"meta": {
+			"sha256": "@sha256@.digestOf(.content)",
+			"md5": "@md5@.digestOf(.content)",
+			"tags": ["a"],
+			"tagsMd5": "@md5@.digestOf(.meta.tags)"
+		}
+	}
+	`, v)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.Match(`
+	{
+		"content": "hello",
+		"meta": {
+			"sha256": "@sha256@.digestOf(.meta.md5)",
+			"md5": "@md5@.digestOf(.missing)",
+			"tags": ["a"],
+			"tagsMd5": "@md5@"
+		}
+	}
+	`, v)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrDigestMismatch))
+	assert.Contains(t, err.Error(), "expected digest of .meta.md5, computed: ")
+	assert.Contains(t, err.Error(), "expected digest of .missing, value not found")
+
+	ok, err = NewSHA256Matcher("@sha256@").Match("@sha256@.digestOf(.content)", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrInvalidPattern))
+}
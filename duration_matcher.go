@@ -0,0 +1,163 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var isoDurationRe = regexp.MustCompile(`^([-+])?P(?:(\d+(?:[.,]\d+)?)Y)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)W)?(?:(\d+(?:[.,]\d+)?)D)?` +
+	`(?:T(?:(\d+(?:[.,]\d+)?)H)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+var (
+	ErrNotDuration        = errors.New("expected duration")
+	ErrDurationNotInRange = errors.New("expected duration between")
+)
+
+// A DurationSyntax defines accepted duration format.
+type DurationSyntax int
+
+const (
+	// DurationISO8601 accepts ISO 8601 durations, e.g. "PT1H30M" or "P1DT12H".
+	DurationISO8601 DurationSyntax = iota
+	// DurationGo accepts durations supported by time.ParseDuration, e.g. "1h30m".
+	DurationGo
+)
+
+// A DurationMatcher matches duration strings.
+//
+// It supports an optional range constraint, bounds are inclusive and may use any supported syntax:
+//
+//	"@duration@.between('PT1S', 'PT1H')"
+//
+// Years, months, weeks and days of ISO 8601 durations are converted using nominal lengths
+// of 365, 30, 7 days and 24 hours.
+type DurationMatcher struct {
+	pattern  string
+	syntaxes []DurationSyntax
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *DurationMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *DurationMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return false, ErrNotDuration
+	}
+	d, ok := parseDuration(s, m.syntaxes)
+	if !ok {
+		return false, ErrNotDuration
+	}
+	for _, c := range calls {
+		switch c.name {
+		case "between":
+			min, max, err := parseDurationRange(c)
+			if err != nil {
+				return false, err
+			}
+			if d < min || d > max {
+				return false, fmt.Errorf("%w %s and %s", ErrDurationNotInRange, c.args[0], c.args[1])
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *DurationMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		if c.name != "between" {
+			return errUnknownConstraint(c)
+		}
+		_, _, err := parseDurationRange(c)
+		return err
+	})
+}
+
+func parseDurationRange(c patternCall) (time.Duration, time.Duration, error) {
+	if len(c.args) != 2 {
+		return 0, 0, errConstraintArgs(c, 2)
+	}
+	min, okMin := parseDuration(c.args[0], allDurationSyntaxes)
+	max, okMax := parseDuration(c.args[1], allDurationSyntaxes)
+	if !okMin || !okMax {
+		return 0, 0, fmt.Errorf("%w: invalid duration range %v", ErrInvalidPattern, c.args)
+	}
+	return min, max, nil
+}
+
+var allDurationSyntaxes = []DurationSyntax{DurationISO8601, DurationGo}
+
+func parseDuration(s string, syntaxes []DurationSyntax) (time.Duration, bool) {
+	for _, syntax := range syntaxes {
+		switch syntax {
+		case DurationISO8601:
+			if d, ok := parseISODuration(s); ok {
+				return d, true
+			}
+		case DurationGo:
+			if d, err := time.ParseDuration(s); err == nil {
+				return d, true
+			}
+		}
+	}
+	return 0, false
+}
+
+var isoDurationUnits = []time.Duration{
+	365 * 24 * time.Hour,
+	30 * 24 * time.Hour,
+	7 * 24 * time.Hour,
+	24 * time.Hour,
+	time.Hour,
+	time.Minute,
+	time.Second,
+}
+
+func parseISODuration(s string) (time.Duration, bool) {
+	parts := isoDurationRe.FindStringSubmatch(s)
+	if parts == nil || strings.HasSuffix(s, "P") || strings.HasSuffix(s, "T") {
+		return 0, false
+	}
+	total := 0.
+	for i, unit := range isoDurationUnits {
+		if parts[i+2] == "" {
+			continue
+		}
+		n, err := strconv.ParseFloat(strings.Replace(parts[i+2], ",", ".", 1), 64)
+		if err != nil {
+			return 0, false
+		}
+		total += n * float64(unit)
+	}
+	if total > math.MaxInt64 {
+		return 0, false
+	}
+	if parts[1] == "-" {
+		total = -total
+	}
+	return time.Duration(total), true
+}
+
+// NewDurationMatcher creates DurationMatcher accepting given syntaxes.
+// When no syntax is given both ISO 8601 and Go durations are accepted.
+func NewDurationMatcher(pattern string, syntaxes ...DurationSyntax) *DurationMatcher {
+	if len(syntaxes) == 0 {
+		syntaxes = allDurationSyntaxes
+	}
+	return &DurationMatcher{pattern, syntaxes}
+}
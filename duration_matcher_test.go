@@ -0,0 +1,121 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var durationMatcherTests = []struct {
+	desc string
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match ISO 8601 duration",
+		"@pattern@",
+		"PT1H30M",
+		true,
+		nil,
+	},
+	{
+		"Should match ISO 8601 duration with date part",
+		"@pattern@",
+		"P1Y2M3W4DT5H6M7.5S",
+		true,
+		nil,
+	},
+	{
+		"Should match Go duration",
+		"@pattern@",
+		"1h30m",
+		true,
+		nil,
+	},
+	{
+		"Should not match empty ISO 8601 duration",
+		"@pattern@",
+		"PT",
+		false,
+		ErrNotDuration,
+	},
+	{
+		"Should not match invalid duration",
+		"@pattern@",
+		"90 minutes",
+		false,
+		ErrNotDuration,
+	},
+	{
+		"Should not match number",
+		"@pattern@",
+		90,
+		false,
+		ErrNotDuration,
+	},
+	{
+		"Should match duration in range",
+		"@pattern@.between('PT1S', 'PT1H')",
+		"30m",
+		true,
+		nil,
+	},
+	{
+		"Should match duration on range bound",
+		"@pattern@.between('PT1S', 'PT1H')",
+		"PT60M",
+		true,
+		nil,
+	},
+	{
+		"Should not match duration out of range",
+		"@pattern@.between('PT1S', 'PT1H')",
+		"P1D",
+		false,
+		ErrDurationNotInRange,
+	},
+	{
+		"Should fail on invalid range",
+		"@pattern@.between('PT1S')",
+		"PT1M",
+		false,
+		ErrInvalidPattern,
+	},
+}
+
+func TestDurationMatcher(t *testing.T) {
+	for _, tt := range durationMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewDurationMatcher("@pattern@")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
+
+func TestDurationMatcherSyntaxes(t *testing.T) {
+	m := NewDurationMatcher("@duration@", DurationISO8601)
+	ok, err := m.Match("@duration@", "PT1H30M")
+	assert.True(t, ok)
+	assert.Nil(t, err)
+
+	ok, err = m.Match("@duration@", "1h30m")
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrNotDuration))
+
+	m = NewDurationMatcher("@duration@", DurationGo)
+	ok, err = m.Match("@duration@", "PT1H30M")
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrNotDuration))
+}
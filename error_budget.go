@@ -0,0 +1,123 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// FailFast stops matching shortly after the first mismatch and reports only it. It is a shorthand for MaxErrors(1).
+func (m *JSONMatcher) FailFast(failFast bool) {
+	m.maxErrors = 0
+	if failFast {
+		m.maxErrors = 1
+	}
+}
+
+// MaxErrors stops matching once more than n mismatches were found and reports the first n, so broken documents
+// do not produce thousands of errors. Result.Truncated is set if some mismatches were dropped.
+// Zero means no limit, which is the default.
+func (m *JSONMatcher) MaxErrors(n int) {
+	m.maxErrors = max(n, 0)
+}
+
+// MaxValueLength truncates expected and provided values longer than n bytes in messages of ErrGomatch,
+// the truncated part is replaced with an elision marker. Values of ErrGomatch fields are kept whole.
+// Zero means no limit, which is the default.
+func (m *JSONMatcher) MaxValueLength(n int) {
+	m.maxValueLength = max(n, 0)
+}
+
+// An errorCollector collects errors of nested values of an object or an array.
+// It keeps count of errors found in the whole match up to date, so matching can stop once the limit is reached.
+type errorCollector struct {
+	s     *matchState
+	base  int
+	count int
+	errs  []error
+}
+
+func (s *matchState) collectErrors() *errorCollector {
+	return &errorCollector{s: s, base: s.errorCount, errs: []error{}}
+}
+
+func (c *errorCollector) add(errs ...error) {
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		c.errs = append(c.errs, err)
+		c.count += countErrors(err)
+	}
+	c.s.errorCount = c.base + c.count
+}
+
+// full returns true if the limit of errors is exceeded. Matching continues until one more error than the limit
+// is found, so truncation of errors is reported only if some were actually dropped.
+func (c *errorCollector) full() bool {
+	return c.s.maxErrors > 0 && c.s.errorCount > c.s.maxErrors
+}
+
+func (c *errorCollector) err() error {
+	return errors.Join(c.errs...)
+}
+
+// countErrors returns number of mismatches in err.
+func countErrors(err error) int {
+	if err == nil {
+		return 0
+	}
+	return max(len(flattenErrors(err)), 1)
+}
+
+// limitErrors keeps first n mismatches of err. Mismatches of union branches are counted and limited one by one.
+func limitErrors(err error, n int) error {
+	var limit func(err error) error
+	limit = func(err error) error {
+		if j, ok := err.(interface{ Unwrap() []error }); ok {
+			if _, isGomatch := err.(ErrGomatch); !isGomatch {
+				errs := []error{}
+				for _, err := range j.Unwrap() {
+					errs = append(errs, limit(err))
+				}
+				return errors.Join(errs...)
+			}
+		}
+		if n <= 0 {
+			return nil
+		}
+		if e, ok := err.(ErrGomatch); ok {
+			if u, ok := e.err.(unionBranchError); ok {
+				u.err = limit(u.err)
+				e.err = u
+				return e
+			}
+		}
+		n -= countErrors(err)
+		return err
+	}
+	return limit(err)
+}
+
+// truncateValue formats value v shortened to n bytes followed by an elision marker.
+// Strings are shortened before they are quoted, so the quotes stay balanced.
+func truncateValue(v interface{}, n int) string {
+	if str, ok := v.(string); ok && n > 0 && len(str) > n {
+		cut := runeCut(str, n)
+		return valueOf(str[:cut]) + fmt.Sprintf("…(%d bytes elided)", len(str)-cut)
+	}
+	formatted := valueOf(v)
+	if _, ok := v.(string); ok || n <= 0 || len(formatted) <= n {
+		return formatted
+	}
+	cut := runeCut(formatted, n)
+	return formatted[:cut] + fmt.Sprintf("…(%d bytes elided)", len(formatted)-cut)
+}
+
+// runeCut returns the largest index not greater than n which does not split a rune of s.
+func runeCut(s string, n int) int {
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return n
+}
@@ -0,0 +1,125 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONMatcherMaxErrors(t *testing.T) {
+	items := []string{}
+	for i := 0; i < 100; i++ {
+		items = append(items, fmt.Sprintf(`{"id": %d}`, i))
+	}
+	p := `{"items": [` + strings.Repeat(`{"id": "@string@"},`, 99) + `{"id": "@string@"}], "total": 1, "name": "x"}`
+	v := `{"items": [` + strings.Join(items, ",") + `], "total": 2, "name": "y"}`
+
+	tests := []struct {
+		desc      string
+		configure func(m *JSONMatcher)
+		count     int
+		truncated bool
+	}{
+		{"no limit", func(m *JSONMatcher) {}, 102, false},
+		{"fail fast", func(m *JSONMatcher) { m.FailFast(true) }, 1, true},
+		{"fail fast disabled", func(m *JSONMatcher) { m.MaxErrors(5); m.FailFast(false) }, 102, false},
+		{"limit", func(m *JSONMatcher) { m.MaxErrors(10) }, 10, true},
+		{"limit at count", func(m *JSONMatcher) { m.MaxErrors(102) }, 102, false},
+		{"limit above count", func(m *JSONMatcher) { m.MaxErrors(500) }, 102, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewDefaultJSONMatcher()
+			tt.configure(m)
+
+			r, err := m.MatchResult(p, v)
+			assert.Nil(t, err)
+			assert.False(t, r.Matched)
+			assert.Len(t, r.Mismatches, tt.count)
+			assert.Equal(t, tt.truncated, r.Truncated)
+			assert.Equal(t, []interface{}{"items", 0, "id"}, r.Mismatches[0].Path)
+
+			_, err = m.Match(p, v)
+			assert.Equal(t, tt.count, countErrors(err))
+		})
+	}
+}
+
+func TestJSONMatcherMaxErrorsStopsMatching(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	m.FailFast(true)
+
+	r, err := m.MatchResult(`[{"a": "@string@"}, {"a": "@string@"}, {"a": "@string@"}]`, `[{"a": 1}, {"a": 2}, {"a": 3}]`)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, r.Stats.Patterns)
+	assert.Equal(t, 2, r.Stats.Objects)
+	assert.True(t, r.Truncated)
+}
+
+func TestJSONMatcherMaxErrorsAtLimit(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	m.FailFast(true)
+
+	r, err := m.MatchResult(`{"a": "@string@", "b": "@string@"}`, `{"a": 1, "b": "x"}`)
+	assert.Nil(t, err)
+	assert.Len(t, r.Mismatches, 1)
+	assert.False(t, r.Truncated)
+}
+
+func TestJSONMatcherMaxErrorsInUnions(t *testing.T) {
+	p := `{"payment": "@union('type', {\"card\": {\"a\": 1, \"b\": 1, \"c\": 1}})@", "total": 1}`
+	v := `{"payment": {"type": "card", "a": 2, "b": 2, "c": 2}, "total": 1}`
+
+	tests := []struct {
+		desc      string
+		max       int
+		count     int
+		truncated bool
+	}{
+		{"limit inside of union", 2, 2, true},
+		{"limit at union errors", 3, 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewDefaultJSONMatcher()
+			m.MaxErrors(tt.max)
+
+			r, err := m.MatchResult(p, v)
+			assert.Nil(t, err)
+			assert.Len(t, r.Mismatches, tt.count)
+			assert.Equal(t, tt.truncated, r.Truncated)
+
+			_, err = m.Match(p, v)
+			assert.Equal(t, tt.count, countErrors(err))
+		})
+	}
+}
+
+func TestJSONMatcherMaxValueLength(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	m.MaxValueLength(10)
+
+	long := strings.Repeat("a", 100)
+	_, err := m.Match(`{"a": "`+long+`", "b": "čččččč"}`, `{"a": "b", "b": "c"}`)
+	assert.Contains(t, err.Error(), `values are not equal at ".a". expected: "aaaaaaaaaa"…(90 bytes elided), provided: "b"`)
+	assert.Contains(t, err.Error(), `expected: "ččččč"…(2 bytes elided), provided: "c"`)
+
+	var e ErrGomatch
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, long, e.Expected)
+}
+
+func TestTruncateValue(t *testing.T) {
+	assert.Equal(t, `"abc"`, truncateValue("abc", 0))
+	assert.Equal(t, `"abc"`, truncateValue("abc", 3))
+	assert.Equal(t, `"ab"…(1 bytes elided)`, truncateValue("abc", 2))
+	assert.Equal(t, `""…(2 bytes elided)`, truncateValue("č", 1))
+	assert.Equal(t, `"a\"b"…(1 bytes elided)`, truncateValue(`a"bc`, 3))
+	assert.Equal(t, `[1,…(4 bytes elided)`, truncateValue([]interface{}{1, 2, 3}, 3))
+	assert.Equal(t, "null", truncateValue(nil, 4))
+}
@@ -1,8 +1,8 @@
 package gomatch
 
 import (
-	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
@@ -18,39 +18,67 @@ type ErrGomatch struct {
 	Key      string
 	Expected any
 	Provided any
-	err      error
+	// Source is a file the expected value was included from, empty for values of the expected JSON itself.
+	Source string
+
+	// ExpectedPosition and ProvidedPosition locate the values in JSON sources.
+	// For values described by patterns, e.g. fragments or union branches, position of the pattern is used.
+	ExpectedPosition Position
+	ProvidedPosition Position
+
+	err            error
+	format         PathFormat
+	maxValueLength int
+
+	// expectedPath is a path of the expected value if it differs from Path, e.g. when keys are compared by CompareKeys.
+	expectedPath []interface{}
 }
 
 func (e ErrGomatch) Error() string {
-	expected := "null"
-	if e.Expected != nil {
-		expected = valueOf(e.Expected)
+	expected, provided := truncateValue(e.Expected, e.maxValueLength), truncateValue(e.Provided, e.maxValueLength)
+	format := e.format
+	if format == nil {
+		format = FormatPathYQ
 	}
-	provided := "null"
-	if e.Provided != nil {
-		provided = valueOf(e.Provided)
+	msg := fmt.Sprintf("%s at %q. expected: %s, provided: %s", e.err, format(e.Path), expected, provided)
+	if e.Source != "" {
+		msg = fmt.Sprintf("%s at %q (included from %s). expected: %s, provided: %s", e.err, format(e.Path), e.Source, expected, provided)
 	}
-	return fmt.Sprintf("%s at %q. expected: %s, provided: %s", e.err, pathToString(e.Path), expected, provided)
+	switch {
+	case e.ExpectedPosition.IsValid() && e.ProvidedPosition.IsValid():
+		return fmt.Sprintf("%s: %s (provided at %s)", e.ExpectedPosition, msg, e.ProvidedPosition)
+	case e.ExpectedPosition.IsValid():
+		return fmt.Sprintf("%s: %s", e.ExpectedPosition, msg)
+	case e.ProvidedPosition.IsValid():
+		return fmt.Sprintf("%s: %s", e.ProvidedPosition, msg)
+	}
+	return msg
 }
 func (e ErrGomatch) Unwrap() error {
 	return e.err
 }
 
-func pathToString(path []interface{}) string {
-	var b bytes.Buffer
-	b.WriteRune('.')
-	for _, p := range path {
-		switch v := p.(type) {
-		case int:
-			b.WriteString(fmt.Sprintf("[%d]", v))
-		default:
-			if b.Len() > 1 {
-				b.WriteRune('.')
-			}
-			b.WriteString(v.(string))
+// mapErrors applies f to ErrGomatch errors of err, including errors of union branches.
+func mapErrors(err error, f func(ErrGomatch) ErrGomatch) error {
+	switch e := err.(type) {
+	case ErrGomatch:
+		if u, ok := e.err.(unionBranchError); ok {
+			u.err = mapErrors(u.err, f)
+			e.err = u
 		}
+		return f(e)
+	case interface{ Unwrap() []error }:
+		errs := []error{}
+		for _, err := range e.Unwrap() {
+			errs = append(errs, mapErrors(err, f))
+		}
+		return errors.Join(errs...)
 	}
-	return b.String()
+	return err
+}
+
+func pathToString(path []interface{}) string {
+	return FormatPathYQ(path)
 }
 
 func valueOf(v interface{}) string {
@@ -0,0 +1,916 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+var ErrExprEval = errors.New("expression evaluation failed")
+
+// An exprEnv resolves identifiers used in an expression.
+type exprEnv func(name string) (interface{}, bool)
+
+// An exprNode is a node of parsed expression.
+type exprNode interface {
+	eval(env exprEnv) (interface{}, error)
+}
+
+// An expr is a parsed expression of a small, side effect free language used by patterns.
+//
+// The language supports:
+//
+//   - literals: numbers, strings in double or single quotes, true, false and null
+//   - identifiers resolved by the environment, member access "a.b", indexes "a[0]", "a['b']"
+//     and projections "items[*].price"
+//   - operators: "||", "&&", "!", "==", "!=", "<", "<=", ">", ">=", "in", "+", "-", "*", "/", "%"
+//     and conditional "a ? b : c"
+//   - functions: len, sum, min, max, abs, contains, startsWith, endsWith, matches, lower, upper, type and date
+type expr struct {
+	src  string
+	root exprNode
+}
+
+func (e *expr) eval(env exprEnv) (interface{}, error) {
+	v, err := e.root.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	return unwrapProjection(v), nil
+}
+
+// evalBool evaluates expression which is expected to return boolean.
+func (e *expr) evalBool(env exprEnv) (bool, error) {
+	v, err := e.eval(env)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: %q returned %s instead of bool", ErrExprEval, e.src, valueOf(v))
+	}
+	return b, nil
+}
+
+// identifiers returns all root identifiers referenced by the expression.
+func (e *expr) identifiers() []string {
+	names := map[string]bool{}
+	collectIdentifiers(e.root, names)
+	ids := []string{}
+	for n := range names {
+		ids = append(ids, n)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func parseExpr(src string) (*expr, error) {
+	tokens, err := lexExpr(src)
+	if err != nil {
+		return nil, fmt.Errorf("%w: expression %q: %s", ErrInvalidPattern, src, err)
+	}
+	p := &exprParser{tokens: tokens}
+	root, err := p.parseConditional()
+	if err == nil && p.peek().kind != tokEOF {
+		err = fmt.Errorf("unexpected %q at %d", p.peek().text, p.peek().pos)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: expression %q: %s", ErrInvalidPattern, src, err)
+	}
+	return &expr{src, root}, nil
+}
+
+// lexer
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokString
+	tokIdent
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+var exprOperators = []string{"||", "&&", "==", "!=", "<=", ">=", "<", ">", "!", "+", "-", "*", "/", "%", "(", ")", "[", "]", ".", ",", "?", ":"}
+
+func lexExpr(src string) ([]token, error) {
+	tokens := []token{}
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c >= '0' && c <= '9':
+			j := i
+			for j < len(src) && (src[j] >= '0' && src[j] <= '9' || src[j] == '.' || src[j] == 'e' || src[j] == 'E' ||
+				(src[j] == '-' || src[j] == '+') && (src[j-1] == 'e' || src[j-1] == 'E')) {
+				j++
+			}
+			tokens = append(tokens, token{tokNumber, src[i:j], i})
+			i = j
+		case c == '"' || c == '\'':
+			s, n, err := parseQuotedKey(src[i:])
+			if err != nil {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			tokens = append(tokens, token{tokString, s, i})
+			i += n
+		case c == '_' || c == '$' || unicode.IsLetter(rune(c)):
+			j := i
+			for j < len(src) && (src[j] == '_' || src[j] == '$' || unicode.IsLetter(rune(src[j])) || unicode.IsDigit(rune(src[j]))) {
+				j++
+			}
+			tokens = append(tokens, token{tokIdent, src[i:j], i})
+			i = j
+		default:
+			op := ""
+			for _, o := range exprOperators {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected %q at %d", c, i)
+			}
+			tokens = append(tokens, token{tokOp, op, i})
+			i += len(op)
+		}
+	}
+	return append(tokens, token{tokEOF, "end of expression", len(src)}), nil
+}
+
+// parser
+
+type exprParser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *exprParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *exprParser) accept(op string) bool {
+	t := p.peek()
+	if (t.kind == tokOp || t.kind == tokIdent && op == "in") && t.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *exprParser) expect(op string) error {
+	if !p.accept(op) {
+		return fmt.Errorf("expected %q at %d, got %q", op, p.peek().pos, p.peek().text)
+	}
+	return nil
+}
+
+func (p *exprParser) parseConditional() (exprNode, error) {
+	cond, err := p.parseBinary(0)
+	if err != nil || !p.accept("?") {
+		return cond, err
+	}
+	then, err := p.parseConditional()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	otherwise, err := p.parseConditional()
+	if err != nil {
+		return nil, err
+	}
+	return &conditionalNode{cond, then, otherwise}, nil
+}
+
+var exprPrecedence = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">=", "in"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *exprParser) parseBinary(level int) (exprNode, error) {
+	if level == len(exprPrecedence) {
+		return p.parseUnary()
+	}
+	left, err := p.parseBinary(level + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op := ""
+		for _, o := range exprPrecedence[level] {
+			if p.accept(o) {
+				op = o
+				break
+			}
+		}
+		if op == "" {
+			return left, nil
+		}
+		right, err := p.parseBinary(level + 1)
+		if err != nil {
+			return nil, err
+		}
+		left = &binaryNode{op, left, right}
+	}
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	for _, op := range []string{"!", "-"} {
+		if p.accept(op) {
+			operand, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			return &unaryNode{op, operand}, nil
+		}
+	}
+	return p.parsePostfix()
+}
+
+func (p *exprParser) parsePostfix() (exprNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch {
+		case p.accept("."):
+			t := p.next()
+			if t.kind != tokIdent {
+				return nil, fmt.Errorf("expected member name at %d, got %q", t.pos, t.text)
+			}
+			node = &memberNode{node, &literalNode{t.text}}
+		case p.accept("["):
+			if p.accept("*") {
+				node = &projectionNode{node}
+			} else {
+				index, err := p.parseConditional()
+				if err != nil {
+					return nil, err
+				}
+				node = &memberNode{node, index}
+			}
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+		default:
+			return node, nil
+		}
+	}
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	t := p.next()
+	switch t.kind {
+	case tokNumber:
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at %d", t.text, t.pos)
+		}
+		return &literalNode{f}, nil
+	case tokString:
+		return &literalNode{t.text}, nil
+	case tokIdent:
+		switch t.text {
+		case "true":
+			return &literalNode{true}, nil
+		case "false":
+			return &literalNode{false}, nil
+		case "null":
+			return &literalNode{nil}, nil
+		}
+		if !p.accept("(") {
+			return &identNode{t.text}, nil
+		}
+		fn, ok := exprFunctions[t.text]
+		if !ok {
+			return nil, fmt.Errorf("unknown function %q at %d", t.text, t.pos)
+		}
+		args := []exprNode{}
+		for !p.accept(")") {
+			if len(args) > 0 {
+				if err := p.expect(","); err != nil {
+					return nil, err
+				}
+			}
+			arg, err := p.parseConditional()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		return &callNode{t.text, fn, args}, nil
+	case tokOp:
+		if t.text == "(" {
+			node, err := p.parseConditional()
+			if err != nil {
+				return nil, err
+			}
+			return node, p.expect(")")
+		}
+		if t.text == "[" {
+			items := []exprNode{}
+			for !p.accept("]") {
+				if len(items) > 0 {
+					if err := p.expect(","); err != nil {
+						return nil, err
+					}
+				}
+				item, err := p.parseConditional()
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+			return &listNode{items}, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q at %d", t.text, t.pos)
+}
+
+// nodes
+
+type literalNode struct {
+	v interface{}
+}
+
+func (n *literalNode) eval(env exprEnv) (interface{}, error) {
+	return n.v, nil
+}
+
+type identNode struct {
+	name string
+}
+
+func (n *identNode) eval(env exprEnv) (interface{}, error) {
+	v, ok := env(n.name)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown identifier %q", ErrExprEval, n.name)
+	}
+	return v, nil
+}
+
+type listNode struct {
+	items []exprNode
+}
+
+func (n *listNode) eval(env exprEnv) (interface{}, error) {
+	list := []interface{}{}
+	for _, item := range n.items {
+		v, err := item.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, unwrapProjection(v))
+	}
+	return list, nil
+}
+
+// A projection is a list produced by "[*]", member access on projection is applied to its items.
+type projection []interface{}
+
+func unwrapProjection(v interface{}) interface{} {
+	if p, ok := v.(projection); ok {
+		return []interface{}(p)
+	}
+	return v
+}
+
+type projectionNode struct {
+	target exprNode
+}
+
+func (n *projectionNode) eval(env exprEnv) (interface{}, error) {
+	v, err := n.target.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch t := unwrapProjection(v).(type) {
+	case []interface{}:
+		return projection(t), nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		p := projection{}
+		for _, k := range keys {
+			p = append(p, t[k])
+		}
+		return p, nil
+	case nil:
+		return projection{}, nil
+	}
+	return nil, fmt.Errorf("%w: cannot project %s", ErrExprEval, valueOf(v))
+}
+
+type memberNode struct {
+	target exprNode
+	key    exprNode
+}
+
+func (n *memberNode) eval(env exprEnv) (interface{}, error) {
+	target, err := n.target.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	key, err := n.key.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	if p, ok := target.(projection); ok {
+		result := projection{}
+		for _, item := range p {
+			v, err := member(item, key)
+			if err != nil {
+				return nil, err
+			}
+			if v != nil {
+				result = append(result, v)
+			}
+		}
+		return result, nil
+	}
+	return member(target, unwrapProjection(key))
+}
+
+func member(target, key interface{}) (interface{}, error) {
+	switch t := target.(type) {
+	case nil:
+		return nil, nil
+	case map[string]interface{}:
+		k, ok := key.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: object key must be string, got %s", ErrExprEval, valueOf(key))
+		}
+		return t[k], nil
+	case []interface{}:
+		f, ok := key.(float64)
+		if !ok || f != math.Trunc(f) {
+			return nil, fmt.Errorf("%w: array index must be integer, got %s", ErrExprEval, valueOf(key))
+		}
+		i := int(f)
+		if i < 0 {
+			i += len(t)
+		}
+		if i < 0 || i >= len(t) {
+			return nil, nil
+		}
+		return t[i], nil
+	}
+	return nil, fmt.Errorf("%w: cannot access %s of %s", ErrExprEval, valueOf(key), valueOf(target))
+}
+
+type unaryNode struct {
+	op      string
+	operand exprNode
+}
+
+func (n *unaryNode) eval(env exprEnv) (interface{}, error) {
+	v, err := n.operand.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch t := v.(type) {
+	case bool:
+		if n.op == "!" {
+			return !t, nil
+		}
+	case float64:
+		if n.op == "-" {
+			return -t, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: invalid operand of %q: %s", ErrExprEval, n.op, valueOf(v))
+}
+
+type conditionalNode struct {
+	cond, then, otherwise exprNode
+}
+
+func (n *conditionalNode) eval(env exprEnv) (interface{}, error) {
+	c, err := evalBoolNode(n.cond, env, "?")
+	if err != nil {
+		return nil, err
+	}
+	if c {
+		return n.then.eval(env)
+	}
+	return n.otherwise.eval(env)
+}
+
+func evalBoolNode(n exprNode, env exprEnv, op string) (bool, error) {
+	v, err := n.eval(env)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: invalid operand of %q: %s", ErrExprEval, op, valueOf(unwrapProjection(v)))
+	}
+	return b, nil
+}
+
+type binaryNode struct {
+	op          string
+	left, right exprNode
+}
+
+func (n *binaryNode) eval(env exprEnv) (interface{}, error) {
+	switch n.op {
+	case "&&", "||":
+		l, err := evalBoolNode(n.left, env, n.op)
+		if err != nil {
+			return nil, err
+		}
+		if l == (n.op == "||") {
+			return l, nil
+		}
+		return evalBoolNode(n.right, env, n.op)
+	}
+	l, err := n.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	r, err := n.right.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	l, r = unwrapProjection(l), unwrapProjection(r)
+	switch n.op {
+	case "==":
+		return reflect.DeepEqual(l, r), nil
+	case "!=":
+		return !reflect.DeepEqual(l, r), nil
+	case "in":
+		return evalIn(l, r)
+	case "<", "<=", ">", ">=":
+		c, err := compareValues(l, r)
+		if err != nil {
+			return nil, err
+		}
+		switch n.op {
+		case "<":
+			return c < 0, nil
+		case "<=":
+			return c <= 0, nil
+		case ">":
+			return c > 0, nil
+		}
+		return c >= 0, nil
+	case "+":
+		switch lt := l.(type) {
+		case string:
+			if rt, ok := r.(string); ok {
+				return lt + rt, nil
+			}
+		case []interface{}:
+			if rt, ok := r.([]interface{}); ok {
+				return append(append([]interface{}{}, lt...), rt...), nil
+			}
+		}
+	}
+	lf, lok := l.(float64)
+	rf, rok := r.(float64)
+	if !lok || !rok {
+		return nil, fmt.Errorf("%w: invalid operands of %q: %s and %s", ErrExprEval, n.op, valueOf(l), valueOf(r))
+	}
+	switch n.op {
+	case "+":
+		return lf + rf, nil
+	case "-":
+		return lf - rf, nil
+	case "*":
+		return lf * rf, nil
+	case "/":
+		if rf == 0 {
+			return nil, fmt.Errorf("%w: division by zero", ErrExprEval)
+		}
+		return lf / rf, nil
+	}
+	if rf == 0 {
+		return nil, fmt.Errorf("%w: division by zero", ErrExprEval)
+	}
+	return math.Mod(lf, rf), nil
+}
+
+func evalIn(l, r interface{}) (interface{}, error) {
+	switch rt := r.(type) {
+	case []interface{}:
+		for _, item := range rt {
+			if reflect.DeepEqual(l, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case map[string]interface{}:
+		k, ok := l.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: object key must be string, got %s", ErrExprEval, valueOf(l))
+		}
+		_, ok = rt[k]
+		return ok, nil
+	case string:
+		s, ok := l.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid operands of \"in\": %s and %s", ErrExprEval, valueOf(l), valueOf(r))
+		}
+		return strings.Contains(rt, s), nil
+	}
+	return nil, fmt.Errorf("%w: invalid operands of \"in\": %s and %s", ErrExprEval, valueOf(l), valueOf(r))
+}
+
+func compareValues(l, r interface{}) (int, error) {
+	switch lt := l.(type) {
+	case float64:
+		if rt, ok := r.(float64); ok {
+			switch {
+			case lt < rt:
+				return -1, nil
+			case lt > rt:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	case string:
+		if rt, ok := r.(string); ok {
+			return compareStrings(lt, rt), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: cannot compare %s and %s", ErrExprEval, valueOf(l), valueOf(r))
+}
+
+// compareStrings orders RFC 3339 dates by time, since dates with different offsets are not ordered lexically.
+// Other strings are compared lexically.
+func compareStrings(l, r string) int {
+	lt, lerr := time.Parse(time.RFC3339Nano, l)
+	rt, rerr := time.Parse(time.RFC3339Nano, r)
+	if lerr != nil || rerr != nil {
+		return strings.Compare(l, r)
+	}
+	return lt.Compare(rt)
+}
+
+type callNode struct {
+	name string
+	fn   exprFunction
+	args []exprNode
+}
+
+func (n *callNode) eval(env exprEnv) (interface{}, error) {
+	args := make([]interface{}, len(n.args))
+	for i, a := range n.args {
+		v, err := a.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = unwrapProjection(v)
+	}
+	v, err := n.fn(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s(): %s", ErrExprEval, n.name, err)
+	}
+	return v, nil
+}
+
+func collectIdentifiers(n exprNode, names map[string]bool) {
+	switch t := n.(type) {
+	case *identNode:
+		names[t.name] = true
+	case *listNode:
+		for _, item := range t.items {
+			collectIdentifiers(item, names)
+		}
+	case *projectionNode:
+		collectIdentifiers(t.target, names)
+	case *memberNode:
+		collectIdentifiers(t.target, names)
+		collectIdentifiers(t.key, names)
+	case *unaryNode:
+		collectIdentifiers(t.operand, names)
+	case *conditionalNode:
+		collectIdentifiers(t.cond, names)
+		collectIdentifiers(t.then, names)
+		collectIdentifiers(t.otherwise, names)
+	case *binaryNode:
+		collectIdentifiers(t.left, names)
+		collectIdentifiers(t.right, names)
+	case *callNode:
+		for _, a := range t.args {
+			collectIdentifiers(a, names)
+		}
+	}
+}
+
+// functions
+
+type exprFunction func(args []interface{}) (interface{}, error)
+
+var exprFunctions map[string]exprFunction
+
+func init() {
+	exprFunctions = map[string]exprFunction{
+		"len":        exprLen,
+		"sum":        exprSum,
+		"min":        func(args []interface{}) (interface{}, error) { return exprExtreme(args, -1) },
+		"max":        func(args []interface{}) (interface{}, error) { return exprExtreme(args, 1) },
+		"abs":        exprAbs,
+		"contains":   stringFunction(strings.Contains),
+		"startsWith": stringFunction(strings.HasPrefix),
+		"endsWith":   stringFunction(strings.HasSuffix),
+		"matches":    exprMatches,
+		"lower":      stringMapFunction(strings.ToLower),
+		"upper":      stringMapFunction(strings.ToUpper),
+		"type":       exprType,
+		"date":       exprDate,
+	}
+}
+
+func expectArgs(args []interface{}, n int) error {
+	if len(args) != n {
+		return fmt.Errorf("expects %d argument(s), got %d", n, len(args))
+	}
+	return nil
+}
+
+func exprLen(args []interface{}) (interface{}, error) {
+	if err := expectArgs(args, 1); err != nil {
+		return nil, err
+	}
+	switch t := args[0].(type) {
+	case string:
+		return float64(len([]rune(t))), nil
+	case []interface{}:
+		return float64(len(t)), nil
+	case map[string]interface{}:
+		return float64(len(t)), nil
+	}
+	return nil, fmt.Errorf("invalid argument %s", valueOf(args[0]))
+}
+
+func numbers(args []interface{}) ([]float64, error) {
+	if len(args) == 1 {
+		if list, ok := args[0].([]interface{}); ok {
+			args = list
+		}
+	}
+	nums := make([]float64, len(args))
+	for i, a := range args {
+		f, ok := a.(float64)
+		if !ok {
+			return nil, fmt.Errorf("expects numbers, got %s", valueOf(a))
+		}
+		nums[i] = f
+	}
+	return nums, nil
+}
+
+func exprSum(args []interface{}) (interface{}, error) {
+	nums, err := numbers(args)
+	if err != nil {
+		return nil, err
+	}
+	sum := 0.
+	for _, n := range nums {
+		sum += n
+	}
+	return sum, nil
+}
+
+func exprExtreme(args []interface{}, sign float64) (interface{}, error) {
+	nums, err := numbers(args)
+	if err != nil {
+		return nil, err
+	}
+	if len(nums) == 0 {
+		return nil, errors.New("expects at least one number")
+	}
+	r := nums[0]
+	for _, n := range nums[1:] {
+		if (n-r)*sign > 0 {
+			r = n
+		}
+	}
+	return r, nil
+}
+
+func exprAbs(args []interface{}) (interface{}, error) {
+	if err := expectArgs(args, 1); err != nil {
+		return nil, err
+	}
+	f, ok := args[0].(float64)
+	if !ok {
+		return nil, fmt.Errorf("expects number, got %s", valueOf(args[0]))
+	}
+	return math.Abs(f), nil
+}
+
+func stringArgs(args []interface{}, n int) ([]string, error) {
+	if err := expectArgs(args, n); err != nil {
+		return nil, err
+	}
+	strs := make([]string, n)
+	for i, a := range args {
+		s, ok := a.(string)
+		if !ok {
+			return nil, fmt.Errorf("expects strings, got %s", valueOf(a))
+		}
+		strs[i] = s
+	}
+	return strs, nil
+}
+
+func stringFunction(fn func(s, sub string) bool) exprFunction {
+	return func(args []interface{}) (interface{}, error) {
+		s, err := stringArgs(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return fn(s[0], s[1]), nil
+	}
+}
+
+func stringMapFunction(fn func(s string) string) exprFunction {
+	return func(args []interface{}) (interface{}, error) {
+		s, err := stringArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return fn(s[0]), nil
+	}
+}
+
+func exprMatches(args []interface{}) (interface{}, error) {
+	s, err := stringArgs(args, 2)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(s[1])
+	if err != nil {
+		return nil, err
+	}
+	return re.MatchString(s[0]), nil
+}
+
+func exprType(args []interface{}) (interface{}, error) {
+	if err := expectArgs(args, 1); err != nil {
+		return nil, err
+	}
+	switch args[0].(type) {
+	case nil:
+		return "null", nil
+	case bool:
+		return "bool", nil
+	case float64:
+		return "number", nil
+	case string:
+		return "string", nil
+	case []interface{}:
+		return "array", nil
+	}
+	return "object", nil
+}
+
+// exprDate converts RFC 3339 date to seconds since Unix epoch so dates can be compared and subtracted.
+func exprDate(args []interface{}) (interface{}, error) {
+	s, err := stringArgs(args, 1)
+	if err != nil {
+		return nil, err
+	}
+	t, err := time.Parse(time.RFC3339Nano, s[0])
+	if err != nil {
+		return nil, err
+	}
+	return float64(t.UnixNano()) / float64(time.Second), nil
+}
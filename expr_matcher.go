@@ -0,0 +1,97 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrExprFalse = errors.New("expected expression to be true")
+
+// An ExprMatcher matches values satisfying an inline expression:
+//
+//	"@expr('value > 0 && value % 2 == 0')@"
+//
+// The expression may use identifiers:
+//
+//   - value - the matched value
+//   - path - path of the matched value, e.g. ".items[0].price"
+//   - root - the whole actual JSON, e.g. "value <= root.limit"
+//
+// Expressions are evaluated by a built-in interpreter without any access to the Go program,
+// they can only read the document. See README for the list of operators and functions.
+type ExprMatcher struct {
+	name string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *ExprMatcher) CanMatch(p interface{}) bool {
+	_, ok := patternFunc(p, m.name)
+	return ok
+}
+
+// Match performs value matching against given pattern.
+// Expressions referencing root are evaluated against null root.
+func (m *ExprMatcher) Match(p, v interface{}) (bool, error) {
+	return m.MatchContext(p, v, MatchContext{})
+}
+
+// MatchContext performs value matching against given pattern using match context
+// to provide path and root identifiers.
+func (m *ExprMatcher) MatchContext(p, v interface{}, ctx MatchContext) (bool, error) {
+	src, err := exprSource(p, m.name)
+	if err != nil {
+		return false, err
+	}
+	e, err := parseExpr(src)
+	if err != nil {
+		return false, err
+	}
+	ok, err := e.evalBool(func(name string) (interface{}, bool) {
+		switch name {
+		case "value":
+			return v, true
+		case "path":
+			return formatPath(ctx.PathFormat, ctx.Path), true
+		case "root":
+			return ctx.Root, true
+		}
+		return nil, false
+	})
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		return false, fmt.Errorf("%w: %s", ErrExprFalse, src)
+	}
+	return true, nil
+}
+
+// ValidatePattern validates expression of pattern p.
+func (m *ExprMatcher) ValidatePattern(p string) error {
+	src, err := exprSource(p, m.name)
+	if err != nil {
+		return err
+	}
+	_, err = parseExpr(src)
+	return err
+}
+
+// exprSource returns expression of function-like pattern, it may be quoted or not.
+func exprSource(p interface{}, name string) (string, error) {
+	src, _ := patternFunc(p, name)
+	trimmed := strings.TrimSpace(src)
+	if trimmed == "" || (trimmed[0] != '"' && trimmed[0] != '\'') {
+		return src, nil
+	}
+	args, n, err := parseCallArgs(trimmed + ")")
+	if err != nil || n != len(trimmed)+1 || len(args) != 1 {
+		return "", fmt.Errorf("%w %q: expected single quoted expression", ErrInvalidPattern, p)
+	}
+	return args[0], nil
+}
+
+// NewExprMatcher creates ExprMatcher handling "@<name>(...)@" patterns, e.g. NewExprMatcher("expr").
+func NewExprMatcher(name string) *ExprMatcher {
+	return &ExprMatcher{name}
+}
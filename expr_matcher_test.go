@@ -0,0 +1,90 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var exprMatcherTests = []struct {
+	desc string
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match value satisfying expression",
+		"@expr(value > 0 && value % 2 == 0)@",
+		4.,
+		true,
+		nil,
+	},
+	{
+		"Should match value satisfying quoted expression",
+		"@expr('startsWith(value, \"ord_\")')@",
+		"ord_123",
+		true,
+		nil,
+	},
+	{
+		"Should not match value not satisfying expression",
+		"@expr('value > 0 && value % 2 == 0')@",
+		3.,
+		false,
+		ErrExprFalse,
+	},
+	{
+		"Should fail on expression not returning bool",
+		"@expr(value + 1)@",
+		3.,
+		false,
+		ErrExprEval,
+	},
+	{
+		"Should fail on invalid expression",
+		"@expr(value >)@",
+		3.,
+		false,
+		ErrInvalidPattern,
+	},
+}
+
+func TestExprMatcher(t *testing.T) {
+	for _, tt := range exprMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewExprMatcher("expr")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
+
+func TestExprMatcherContext(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+
+	ok, err := m.Match(`
+	{
+		"limit": 100,
+		"items": [
+			{"price": "@expr(value <= root.limit && path == '.items[0].price')@"}
+		]
+	}
+	`, `{"limit": 100, "items": [{"price": 99}]}`)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.Match(`{"limit": 100, "price": "@expr('value <= root.limit')@"}`, `{"limit": 100, "price": 101}`)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrExprFalse))
+	assert.Contains(t, err.Error(), `expected expression to be true: value <= root.limit at ".price"`)
+}
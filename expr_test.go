@@ -0,0 +1,96 @@
+package gomatch
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const exprTestDocument = `
+{
+	"count": 2,
+	"total": 30.5,
+	"name": "John Smith",
+	"startDate": "2024-01-01T00:00:00Z",
+	"endDate": "2024-01-02T12:00:00Z",
+	"items": [
+		{"price": 10, "tags": ["a", "b"]},
+		{"price": 20.5, "tags": []}
+	]
+}
+`
+
+var exprTests = []struct {
+	desc string
+	src  string
+	v    interface{}
+	err  error
+}{
+	{"Should evaluate arithmetic", "1 + 2 * 3 - 4 / 2 == 5", true, nil},
+	{"Should evaluate modulo", "count % 2 == 0", true, nil},
+	{"Should evaluate unary operators", "!(-count > 0)", true, nil},
+	{"Should evaluate logical operators", "count > 1 && total < 100 || false", true, nil},
+	{"Should short-circuit logical operators", "count == 2 || missing", true, nil},
+	{"Should evaluate conditional", "count > 1 ? 'many' : 'one'", "many", nil},
+	{"Should compare strings", "name < 'Joe'", false, nil},
+	{"Should compare dates", "endDate > startDate && '2024-01-02T13:00:00+14:00' < endDate", true, nil},
+	{"Should concatenate strings", `"a" + 'b'`, "ab", nil},
+	{"Should access members and indexes", "items[1].price + items[0]['price']", 30.5, nil},
+	{"Should access negative indexes", "items[-1].price", 20.5, nil},
+	{"Should return null for missing member", "items[5].price == null", true, nil},
+	{"Should project arrays", "items[*].price", []interface{}{10., 20.5}, nil},
+	{"Should sum projection", "total == sum(items[*].price)", true, nil},
+	{"Should flatten nested projection", "len(items[*].tags)", 2., nil},
+	{"Should evaluate in operator", "'a' in items[0].tags && 'price' in items[0] && 'Smith' in name", true, nil},
+	{"Should evaluate list literal", "count in [1, 2, 3]", true, nil},
+	{"Should evaluate len", "count == len(items) && len(name) == 10", true, nil},
+	{"Should evaluate min and max", "min(items[*].price) == 10 && max(1, 5, 3) == 5", true, nil},
+	{"Should evaluate string functions", "startsWith(lower(name), 'john') && endsWith(upper(name), 'SMITH') && contains(name, ' ')", true, nil},
+	{"Should evaluate matches", "matches(name, '^[A-Z][a-z]+ [A-Z][a-z]+$')", true, nil},
+	{"Should evaluate type", "type(items) + type(count) + type(null)", "arraynumbernull", nil},
+	{"Should evaluate date", "date(endDate) - date(startDate) == 36 * 3600", true, nil},
+	{"Should fail on unknown identifier", "missing > 1", nil, ErrExprEval},
+	{"Should fail on invalid operands", "name > 1", nil, ErrExprEval},
+	{"Should fail on division by zero", "count / 0", nil, ErrExprEval},
+	{"Should fail on non boolean logical operand", "count && true", nil, ErrExprEval},
+	{"Should fail on invalid function arguments", "sum(name)", nil, ErrExprEval},
+	{"Should fail on unknown function", "exec('rm')", nil, ErrInvalidPattern},
+	{"Should fail on syntax error", "count >", nil, ErrInvalidPattern},
+	{"Should fail on unterminated string", "name == 'John", nil, ErrInvalidPattern},
+	{"Should fail on trailing tokens", "count count", nil, ErrInvalidPattern},
+}
+
+func TestExpr(t *testing.T) {
+	var doc map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(exprTestDocument), &doc))
+	env := func(name string) (interface{}, bool) {
+		v, ok := doc[name]
+		return v, ok
+	}
+
+	for _, tt := range exprTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			e, err := parseExpr(tt.src)
+			if err == nil {
+				var v interface{}
+				v, err = e.eval(env)
+				if tt.err == nil {
+					assert.Equal(t, tt.v, v)
+				}
+			}
+			if tt.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.True(t, errors.Is(err, tt.err), "unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestExprIdentifiers(t *testing.T) {
+	e, err := parseExpr("total == sum(items[*].price) && count == len(items) && 'a' in tags")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"count", "items", "tags", "total"}, e.identifiers())
+}
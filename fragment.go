@@ -0,0 +1,109 @@
+package gomatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrUnknownFragment = errors.New("unknown fragment")
+	ErrFragmentCycle   = errors.New("fragment cycle")
+)
+
+const patternRef = "ref"
+
+// RegisterFragment registers a named JSON pattern which can be referenced from other patterns
+// as "@ref(name)@". An array of values matching the fragment may be referenced as "@ref(name[])@".
+//
+// Fragments may reference other fragments as well as themselves, which allows to describe
+// tree-shaped data, e.g. comment threads:
+//
+//	m.RegisterFragment("comment", `{"text": "@string@", "replies": "@ref(comment[])@"}`)
+//
+// Registering a fragment with the same name replaces the previous one.
+func (m *JSONMatcher) RegisterFragment(name, pattern string) error {
+	if name == "" || strings.ContainsAny(name, "()[]@") {
+		return fmt.Errorf("%w: invalid fragment name %q", ErrInvalidPattern, name)
+	}
+	var p interface{}
+	if err := json.Unmarshal([]byte(pattern), &p); err != nil {
+		return fmt.Errorf("%w %q: %s", errInvalidJSONPattern, name, err)
+	}
+	if m.fragments == nil {
+		m.fragments = map[string]interface{}{}
+	}
+	m.fragments[name] = p
+	return nil
+}
+
+// errUnknownFragment returns error of unknown fragment name, with a suggestion if it is close to a registered one.
+func (m *JSONMatcher) errUnknownFragment(name string) error {
+	if n, ok := closest(name, slices.Sorted(maps.Keys(m.fragments))); ok {
+		return fmt.Errorf("%w %q: did you mean %q?", ErrUnknownFragment, name, n)
+	}
+	return fmt.Errorf("%w %q", ErrUnknownFragment, name)
+}
+
+// parseRef returns name of fragment referenced by p and whether an array of fragments is referenced.
+func parseRef(p interface{}) (string, bool, bool) {
+	name, ok := patternFunc(p, patternRef)
+	if !ok {
+		return "", false, false
+	}
+	name = strings.TrimSpace(name)
+	if n, ok := strings.CutSuffix(name, "[]"); ok {
+		return strings.TrimSpace(n), true, true
+	}
+	return name, false, true
+}
+
+// matchRef matches actual value with referenced fragment.
+//
+// References are expanded lazily while actual JSON is traversed, so recursive fragments
+// are bounded by depth of actual JSON. References expanded for the same value are tracked
+// to detect fragments referencing themselves without describing any value, e.g. "a" -> "@ref(b)@" -> "@ref(a)@".
+func (m *JSONMatcher) matchRef(s *matchState, name string, each bool, expected, actual interface{}, path []interface{}) error {
+	fragment, ok := m.fragments[name]
+	if !ok {
+		return NewErrGomatch(m.errUnknownFragment(name), path, expected, actual, "")
+	}
+	if each {
+		items, ok := actual.([]interface{})
+		if !ok {
+			return NewErrGomatch(ErrNotArray, path, expected, actual, "")
+		}
+		errs := s.collectErrors()
+		for i, item := range items {
+			if errs.full() {
+				break
+			}
+			errs.add(m.matchRef(s, name, false, expected, item, appendPath(path, i)))
+		}
+		return errs.err()
+	}
+
+	refs, depth := s.refs, s.refsDepth
+	defer func() { s.refs, s.refsDepth = refs, depth }()
+	if s.refsDepth != len(path) {
+		s.refs, s.refsDepth = nil, len(path)
+	}
+	if slices.Contains(s.refs, name) {
+		cycle := strings.Join(append(slices.Clone(s.refs), name), " -> ")
+		return NewErrGomatch(fmt.Errorf("%w %s", ErrFragmentCycle, cycle), path, expected, actual, "")
+	}
+	s.refs = append(slices.Clone(s.refs), name)
+	fragment, err := s.substituteParams(fragment, path)
+	if err != nil {
+		return err
+	}
+	return m.deepMatch(s, fragment, actual, path)
+}
+
+func isRef(p interface{}) bool {
+	_, _, ok := parseRef(p)
+	return ok
+}
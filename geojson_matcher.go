@@ -0,0 +1,242 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+var (
+	ErrNotGeoJSON       = errors.New("expected GeoJSON")
+	ErrGeoJSONType      = errors.New("expected GeoJSON type")
+	ErrGeoJSONNotWithin = errors.New("expected GeoJSON within bounding box")
+)
+
+// A GeoJSONMatcher matches RFC 7946 GeoJSON objects: geometries, features and feature collections.
+// It validates structure of coordinates, including closure of polygon rings.
+//
+// It supports optional constraints:
+//
+//	"@geojson@.type(Point|Polygon)"            - object of one of given types
+//	"@geojson@.within(13.08, 52.33, 13.76, 52.67)" - all positions inside of bounding box
+//
+// Bounding box is given in GeoJSON order: west longitude, south latitude, east longitude, north latitude.
+type GeoJSONMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *GeoJSONMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *GeoJSONMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return false, ErrNotGeoJSON
+	}
+	positions := [][]float64{}
+	if err := validateGeoJSON(obj, &positions); err != nil {
+		return false, fmt.Errorf("%w: %s", ErrNotGeoJSON, err)
+	}
+	for _, c := range calls {
+		switch c.name {
+		case "type":
+			types := splitAlternatives(c.args)
+			if typ, _ := obj["type"].(string); !slices.Contains(types, typ) {
+				return false, fmt.Errorf("%w %v, got %q", ErrGeoJSONType, types, typ)
+			}
+		case "within":
+			bbox, err := parseBoundingBox(c)
+			if err != nil {
+				return false, err
+			}
+			for _, pos := range positions {
+				if pos[0] < bbox[0] || pos[1] < bbox[1] || pos[0] > bbox[2] || pos[1] > bbox[3] {
+					return false, fmt.Errorf("%w %v, position %v is outside", ErrGeoJSONNotWithin, bbox, pos[:2])
+				}
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *GeoJSONMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		switch c.name {
+		case "type":
+			if len(c.args) == 0 {
+				return errConstraintArgs(c, 1)
+			}
+			return nil
+		case "within":
+			_, err := parseBoundingBox(c)
+			return err
+		}
+		return errUnknownConstraint(c)
+	})
+}
+
+func parseBoundingBox(c patternCall) ([4]float64, error) {
+	bbox := [4]float64{}
+	if len(c.args) != 4 {
+		return bbox, errConstraintArgs(c, 4)
+	}
+	for i, arg := range c.args {
+		f, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return bbox, fmt.Errorf("%w: invalid bounding box %v", ErrInvalidPattern, c.args)
+		}
+		bbox[i] = f
+	}
+	return bbox, nil
+}
+
+// validateGeoJSON validates GeoJSON object and collects all its positions.
+func validateGeoJSON(obj map[string]interface{}, positions *[][]float64) error {
+	typ, _ := obj["type"].(string)
+	switch typ {
+	case "FeatureCollection":
+		features, ok := obj["features"].([]interface{})
+		if !ok {
+			return errors.New(`FeatureCollection expects "features" array`)
+		}
+		for _, f := range features {
+			feature, ok := f.(map[string]interface{})
+			if !ok || feature["type"] != "Feature" {
+				return errors.New("FeatureCollection expects features")
+			}
+			if err := validateGeoJSON(feature, positions); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "Feature":
+		if _, ok := obj["properties"]; !ok {
+			return errors.New(`Feature expects "properties" member`)
+		}
+		if props := obj["properties"]; props != nil {
+			if _, ok := props.(map[string]interface{}); !ok {
+				return errors.New(`Feature expects "properties" object`)
+			}
+		}
+		g, ok := obj["geometry"]
+		if !ok {
+			return errors.New(`Feature expects "geometry" member`)
+		}
+		if g == nil {
+			return nil
+		}
+		geometry, ok := g.(map[string]interface{})
+		if !ok || !isGeometryType(geometry["type"]) {
+			return errors.New("Feature expects geometry")
+		}
+		return validateGeoJSON(geometry, positions)
+	case "GeometryCollection":
+		geometries, ok := obj["geometries"].([]interface{})
+		if !ok {
+			return errors.New(`GeometryCollection expects "geometries" array`)
+		}
+		for _, g := range geometries {
+			geometry, ok := g.(map[string]interface{})
+			if !ok || !isGeometryType(geometry["type"]) {
+				return errors.New("GeometryCollection expects geometries")
+			}
+			if err := validateGeoJSON(geometry, positions); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	depth, ok := geometryDepths[typ]
+	if !ok {
+		return fmt.Errorf("unknown type %q", obj["type"])
+	}
+	if err := validateCoordinates(typ, obj["coordinates"], depth, positions); err != nil {
+		return fmt.Errorf("%s %s", typ, err)
+	}
+	return nil
+}
+
+// geometryDepths defines nesting of coordinates arrays, 0 means single position.
+var geometryDepths = map[string]int{
+	"Point":           0,
+	"MultiPoint":      1,
+	"LineString":      1,
+	"MultiLineString": 2,
+	"Polygon":         2,
+	"MultiPolygon":    3,
+}
+
+func isGeometryType(t interface{}) bool {
+	s, _ := t.(string)
+	_, ok := geometryDepths[s]
+	return ok || s == "GeometryCollection"
+}
+
+func validateCoordinates(typ string, coords interface{}, depth int, positions *[][]float64) error {
+	if depth == 0 {
+		pos, err := parsePosition(coords)
+		if err != nil {
+			return err
+		}
+		*positions = append(*positions, pos)
+		return nil
+	}
+	arr, ok := coords.([]interface{})
+	if !ok {
+		return errors.New("expects coordinates array")
+	}
+	for _, c := range arr {
+		if err := validateCoordinates(typ, c, depth-1, positions); err != nil {
+			return err
+		}
+	}
+	switch {
+	case depth == 1 && (typ == "LineString" || typ == "MultiLineString") && len(arr) < 2:
+		return errors.New("expects at least 2 positions")
+	case depth == 1 && (typ == "Polygon" || typ == "MultiPolygon"):
+		if len(arr) < 4 {
+			return errors.New("expects linear rings with at least 4 positions")
+		}
+		first, _ := parsePosition(arr[0])
+		last, _ := parsePosition(arr[len(arr)-1])
+		if !slices.Equal(first, last) {
+			return errors.New("expects closed linear rings")
+		}
+	}
+	return nil
+}
+
+func parsePosition(v interface{}) ([]float64, error) {
+	arr, ok := v.([]interface{})
+	if !ok || len(arr) < 2 {
+		return nil, errors.New("expects positions of at least 2 numbers")
+	}
+	pos := make([]float64, len(arr))
+	for i, c := range arr {
+		f, ok := c.(float64)
+		if !ok {
+			return nil, errors.New("expects positions of at least 2 numbers")
+		}
+		pos[i] = f
+	}
+	if pos[0] < -180 || pos[0] > 180 || pos[1] < -90 || pos[1] > 90 {
+		return nil, fmt.Errorf("position %v is out of range", pos)
+	}
+	return pos, nil
+}
+
+// NewGeoJSONMatcher creates GeoJSONMatcher.
+func NewGeoJSONMatcher(pattern string) *GeoJSONMatcher {
+	return &GeoJSONMatcher{pattern}
+}
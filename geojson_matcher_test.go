@@ -0,0 +1,165 @@
+package gomatch
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const berlin = "@pattern@.within(13.08, 52.33, 13.76, 52.67)"
+
+var geoJSONMatcherTests = []struct {
+	desc string
+	p    string
+	v    string
+	ok   bool
+	err  error
+}{
+	{
+		"Should match point",
+		"@pattern@",
+		`{"type": "Point", "coordinates": [13.40, 52.52]}`,
+		true,
+		nil,
+	},
+	{
+		"Should match polygon",
+		"@pattern@",
+		`{"type": "Polygon", "coordinates": [[[13.1, 52.4], [13.7, 52.4], [13.7, 52.6], [13.1, 52.4]]]}`,
+		true,
+		nil,
+	},
+	{
+		"Should not match polygon with open ring",
+		"@pattern@",
+		`{"type": "Polygon", "coordinates": [[[13.1, 52.4], [13.7, 52.4], [13.7, 52.6], [13.1, 52.6]]]}`,
+		false,
+		ErrNotGeoJSON,
+	},
+	{
+		"Should not match polygon with short ring",
+		"@pattern@",
+		`{"type": "Polygon", "coordinates": [[[13.1, 52.4], [13.7, 52.4], [13.1, 52.4]]]}`,
+		false,
+		ErrNotGeoJSON,
+	},
+	{
+		"Should not match line string with single position",
+		"@pattern@",
+		`{"type": "LineString", "coordinates": [[13.1, 52.4]]}`,
+		false,
+		ErrNotGeoJSON,
+	},
+	{
+		"Should not match position out of range",
+		"@pattern@",
+		`{"type": "Point", "coordinates": [52.52, 113.40]}`,
+		false,
+		ErrNotGeoJSON,
+	},
+	{
+		"Should not match unknown type",
+		"@pattern@",
+		`{"type": "Circle", "coordinates": [13.40, 52.52]}`,
+		false,
+		ErrNotGeoJSON,
+	},
+	{
+		"Should match feature collection",
+		"@pattern@",
+		`{"type": "FeatureCollection", "features": [
+			{"type": "Feature", "properties": {"name": "Brandenburg Gate"}, "geometry": {"type": "Point", "coordinates": [13.3777, 52.5163]}},
+			{"type": "Feature", "properties": null, "geometry": null}
+		]}`,
+		true,
+		nil,
+	},
+	{
+		"Should match geometry collection",
+		"@pattern@",
+		`{"type": "GeometryCollection", "geometries": [
+			{"type": "Point", "coordinates": [13.40, 52.52]},
+			{"type": "MultiLineString", "coordinates": [[[13.1, 52.4], [13.7, 52.4]]]}
+		]}`,
+		true,
+		nil,
+	},
+	{
+		"Should not match feature without geometry",
+		"@pattern@",
+		`{"type": "Feature", "properties": {}}`,
+		false,
+		ErrNotGeoJSON,
+	},
+	{
+		"Should match type",
+		"@pattern@.type(Point|MultiPoint)",
+		`{"type": "Point", "coordinates": [13.40, 52.52]}`,
+		true,
+		nil,
+	},
+	{
+		"Should not match other type",
+		"@pattern@.type(Polygon)",
+		`{"type": "Point", "coordinates": [13.40, 52.52]}`,
+		false,
+		ErrGeoJSONType,
+	},
+	{
+		"Should match point inside bounding box",
+		berlin,
+		`{"type": "Point", "coordinates": [13.40, 52.52]}`,
+		true,
+		nil,
+	},
+	{
+		"Should not match point outside of bounding box",
+		berlin,
+		`{"type": "Point", "coordinates": [17.11, 48.15]}`,
+		false,
+		ErrGeoJSONNotWithin,
+	},
+	{
+		"Should not match feature partially outside of bounding box",
+		berlin,
+		`{"type": "Feature", "properties": {}, "geometry": {"type": "LineString", "coordinates": [[13.40, 52.52], [17.11, 48.15]]}}`,
+		false,
+		ErrGeoJSONNotWithin,
+	},
+	{
+		"Should fail on invalid bounding box",
+		"@pattern@.within(13.08, 52.33)",
+		`{"type": "Point", "coordinates": [13.40, 52.52]}`,
+		false,
+		ErrInvalidPattern,
+	},
+	{
+		"Should not match string",
+		"@pattern@",
+		`"POINT (13.40 52.52)"`,
+		false,
+		ErrNotGeoJSON,
+	},
+}
+
+func TestGeoJSONMatcher(t *testing.T) {
+	for _, tt := range geoJSONMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewGeoJSONMatcher("@pattern@")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			var v interface{}
+			assert.Nil(t, json.Unmarshal([]byte(tt.v), &v))
+			ok, err := m.Match(tt.p, v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
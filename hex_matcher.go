@@ -0,0 +1,96 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var hexRe = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+
+var (
+	ErrNotHex    = errors.New("expected hex string")
+	ErrHexLength = errors.New("expected hex string of length")
+)
+
+// A HexMatcher matches hex encoded strings.
+//
+// It supports an optional length constraint, the length is given in characters:
+//
+//	"@hex@.length(64)"     - exactly 64 characters
+//	"@hex@.length(8, 64)"  - from 8 to 64 characters
+type HexMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *HexMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *HexMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	s, ok := v.(string)
+	if !ok || !hexRe.MatchString(s) {
+		return false, ErrNotHex
+	}
+	for _, c := range calls {
+		switch c.name {
+		case "length":
+			min, max, err := parseLengthRange(c)
+			if err != nil {
+				return false, err
+			}
+			if len(s) < min || len(s) > max {
+				return false, fmt.Errorf("%w %s", ErrHexLength, formatLengthRange(min, max))
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *HexMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		if c.name != "length" {
+			return errUnknownConstraint(c)
+		}
+		_, _, err := parseLengthRange(c)
+		return err
+	})
+}
+
+// parseLengthRange parses "length(n)" or "length(min, max)" constraint.
+func parseLengthRange(c patternCall) (int, int, error) {
+	if len(c.args) != 1 && len(c.args) != 2 {
+		return 0, 0, errConstraintArgs(c, 2)
+	}
+	bounds := []int{}
+	for _, arg := range c.args {
+		n, err := strconv.Atoi(arg)
+		if err != nil || n < 0 {
+			return 0, 0, fmt.Errorf("%w: invalid length %q", ErrInvalidPattern, arg)
+		}
+		bounds = append(bounds, n)
+	}
+	return bounds[0], bounds[len(bounds)-1], nil
+}
+
+func formatLengthRange(min, max int) string {
+	if min == max {
+		return strconv.Itoa(min)
+	}
+	return fmt.Sprintf("%d-%d", min, max)
+}
+
+// NewHexMatcher creates HexMatcher.
+func NewHexMatcher(pattern string) *HexMatcher {
+	return &HexMatcher{pattern}
+}
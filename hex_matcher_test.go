@@ -0,0 +1,91 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var hexMatcherTests = []struct {
+	desc string
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match hex",
+		"@pattern@",
+		"deadBEEF01",
+		true,
+		nil,
+	},
+	{
+		"Should not match non hex string",
+		"@pattern@",
+		"0xdeadbeef",
+		false,
+		ErrNotHex,
+	},
+	{
+		"Should not match empty string",
+		"@pattern@",
+		"",
+		false,
+		ErrNotHex,
+	},
+	{
+		"Should match hex of given length",
+		"@pattern@.length(8)",
+		"deadbeef",
+		true,
+		nil,
+	},
+	{
+		"Should not match hex of other length",
+		"@pattern@.length(8)",
+		"deadbeef00",
+		false,
+		ErrHexLength,
+	},
+	{
+		"Should match hex of length in range",
+		"@pattern@.length(8, 16)",
+		"deadbeef00",
+		true,
+		nil,
+	},
+	{
+		"Should fail on invalid length",
+		"@pattern@.length(-1)",
+		"deadbeef00",
+		false,
+		ErrInvalidPattern,
+	},
+	{
+		"Should not match number",
+		"@pattern@",
+		123,
+		false,
+		ErrNotHex,
+	},
+}
+
+func TestHexMatcher(t *testing.T) {
+	for _, tt := range hexMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewHexMatcher("@pattern@")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
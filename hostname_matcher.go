@@ -0,0 +1,34 @@
+package gomatch
+
+import (
+	"errors"
+	"regexp"
+)
+
+var hostnameRe = regexp.MustCompile(`^[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*\.?$`)
+
+var ErrNotHostname = errors.New("expected hostname")
+
+// A HostnameMatcher matches RFC 1123 hostnames.
+type HostnameMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *HostnameMatcher) CanMatch(p interface{}) bool {
+	return isPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *HostnameMatcher) Match(p, v interface{}) (bool, error) {
+	s, ok := v.(string)
+	if !ok || len(s) > 253 || !hostnameRe.MatchString(s) {
+		return false, ErrNotHostname
+	}
+	return true, nil
+}
+
+// NewHostnameMatcher creates HostnameMatcher.
+func NewHostnameMatcher(pattern string) *HostnameMatcher {
+	return &HostnameMatcher{pattern}
+}
@@ -0,0 +1,66 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var hostnameMatcherTests = []struct {
+	desc string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match domain",
+		"api.example.com",
+		true,
+		nil,
+	},
+	{
+		"Should match single label",
+		"localhost",
+		true,
+		nil,
+	},
+	{
+		"Should not match hostname with underscore",
+		"my_host.example.com",
+		false,
+		ErrNotHostname,
+	},
+	{
+		"Should not match label starting with dash",
+		"-host.example.com",
+		false,
+		ErrNotHostname,
+	},
+	{
+		"Should not match number",
+		123,
+		false,
+		ErrNotHostname,
+	},
+}
+
+func TestHostnameMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range hostnameMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewHostnameMatcher(pattern)
+			assert.True(t, m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package gomatch
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+var ibanRe = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]+$`)
+
+var ErrNotIBAN = errors.New("expected IBAN")
+
+// An IBANMatcher matches International Bank Account Numbers.
+// It checks country specific length and the mod 97 checksum.
+// Both electronic ("SK3112000000198742637541") and print format ("SK31 1200 0000 1987 4263 7541") are accepted.
+type IBANMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *IBANMatcher) CanMatch(p interface{}) bool {
+	return isPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *IBANMatcher) Match(p, v interface{}) (bool, error) {
+	s, ok := v.(string)
+	if !ok || !isIBAN(s) {
+		return false, ErrNotIBAN
+	}
+	return true, nil
+}
+
+func isIBAN(s string) bool {
+	if strings.Contains(s, " ") {
+		groups := strings.Split(s, " ")
+		for i, g := range groups {
+			if len(g) != 4 && (i != len(groups)-1 || len(g) == 0 || len(g) > 4) {
+				return false
+			}
+		}
+		s = strings.Join(groups, "")
+	}
+	if !ibanRe.MatchString(s) || ibanLengths[s[:2]] != len(s) {
+		return false
+	}
+	remainder := 0
+	for _, c := range s[4:] + s[:4] {
+		if c >= 'A' {
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		} else {
+			remainder = (remainder*10 + int(c-'0')) % 97
+		}
+	}
+	return remainder == 1
+}
+
+// NewIBANMatcher creates IBANMatcher.
+func NewIBANMatcher(pattern string) *IBANMatcher {
+	return &IBANMatcher{pattern}
+}
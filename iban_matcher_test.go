@@ -0,0 +1,78 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ibanMatcherTests = []struct {
+	desc string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match IBAN",
+		"SK3112000000198742637541",
+		true,
+		nil,
+	},
+	{
+		"Should match IBAN in print format",
+		"GB82 WEST 1234 5698 7654 32",
+		true,
+		nil,
+	},
+	{
+		"Should not match invalid checksum",
+		"SK3112000000198742637542",
+		false,
+		ErrNotIBAN,
+	},
+	{
+		"Should not match invalid length",
+		"SK311200000019874263754",
+		false,
+		ErrNotIBAN,
+	},
+	{
+		"Should not match unknown country",
+		"XX3112000000198742637541",
+		false,
+		ErrNotIBAN,
+	},
+	{
+		"Should not match irregular grouping",
+		"GB82WEST 1234 5698 7654 32",
+		false,
+		ErrNotIBAN,
+	},
+	{
+		"Should not match number",
+		123,
+		false,
+		ErrNotIBAN,
+	},
+}
+
+func TestIBANMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range ibanMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewIBANMatcher(pattern)
+			assert.True(t, m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package gomatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrInclude      = errors.New("cannot include")
+	ErrIncludeCycle = errors.New("include cycle")
+)
+
+const patternInclude = "include"
+
+// IncludeFS sets file system used to resolve "@include(...)@" patterns, e.g. embed.FS.
+// By default files are resolved against the current working directory.
+func (m *JSONMatcher) IncludeFS(fsys fs.FS) {
+	m.includeFS = fsys
+}
+
+// includeFiles returns file system of included files.
+func (m *JSONMatcher) includeFiles() fs.FS {
+	if m.includeFS == nil {
+		return os.DirFS(".")
+	}
+	return m.includeFS
+}
+
+// includeName returns file name of include pattern p.
+func includeName(p interface{}) (string, bool, error) {
+	if !isInclude(p) {
+		return "", false, nil
+	}
+	name, err := patternFuncArg(p, patternInclude)
+	if err != nil || name == "" {
+		return "", true, fmt.Errorf("%w %q: expected single file name", ErrInvalidPattern, p)
+	}
+	return name, true, nil
+}
+
+// matchInclude matches actual value with pattern read from included file.
+//
+// Names are resolved relative to the file containing the include pattern,
+// names starting with "/" are resolved against root of the file system.
+// Errors of included pattern mention the file as their source.
+func (m *JSONMatcher) matchInclude(s *matchState, name string, expected, actual interface{}, p []interface{}) error {
+	file := path.Clean(strings.TrimPrefix(name, "/"))
+	if len(s.includes) > 0 && !strings.HasPrefix(name, "/") {
+		file = path.Join(path.Dir(s.includes[len(s.includes)-1]), name)
+	}
+	if slices.Contains(s.includes, file) {
+		cycle := strings.Join(append(slices.Clone(s.includes), file), " -> ")
+		return NewErrGomatch(fmt.Errorf("%w %s", ErrIncludeCycle, cycle), p, expected, actual, "")
+	}
+	included, err := m.readInclude(s, file)
+	if err != nil {
+		return NewErrGomatch(err, p, expected, actual, "")
+	}
+
+	includes := s.includes
+	defer func() { s.includes = includes }()
+	s.includes = append(slices.Clone(includes), file)
+	pattern, err := s.substituteParams(included.pattern, p)
+	if err == nil {
+		err = m.deepMatch(s, pattern, actual, p)
+	}
+	if err != nil {
+		err = withPositions(err, s.includedPositions(file), len(p), nil)
+	}
+	return withSource(err, file)
+}
+
+// An includedFile is a parsed included pattern, positions of its values are scanned on first use.
+type includedFile struct {
+	pattern   interface{}
+	source    []byte
+	positions *sourcePositions
+}
+
+func (s *matchState) includedPositions(file string) *sourcePositions {
+	f := s.included[file]
+	if f.positions == nil {
+		f.positions = scanPositions(f.source, file)
+		s.included[file] = f
+	}
+	return f.positions
+}
+
+func (m *JSONMatcher) readInclude(s *matchState, file string) (includedFile, error) {
+	if f, ok := s.included[file]; ok {
+		return f, nil
+	}
+	b, err := fs.ReadFile(m.includeFiles(), file)
+	if err != nil {
+		return includedFile{}, fmt.Errorf("%w %q: %w", ErrInclude, file, err)
+	}
+	f := includedFile{source: b}
+	if err := json.Unmarshal(b, &f.pattern); err != nil {
+		return includedFile{}, fmt.Errorf("%w %q: %w: %s", ErrInclude, file, errInvalidJSONPattern, err)
+	}
+	if s.included == nil {
+		s.included = map[string]includedFile{}
+	}
+	s.included[file] = f
+	return f, nil
+}
+
+// withSource sets source of errors which do not have it yet.
+func withSource(err error, source string) error {
+	return mapErrors(err, func(e ErrGomatch) ErrGomatch {
+		if e.Source == "" {
+			e.Source = source
+		}
+		return e
+	})
+}
+
+func isInclude(p interface{}) bool {
+	_, ok := patternFunc(p, patternInclude)
+	return ok
+}
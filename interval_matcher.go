@@ -0,0 +1,116 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var intervalRepeatRe = regexp.MustCompile(`^R\d*$`)
+
+var (
+	ErrNotInterval         = errors.New("expected interval")
+	ErrIntervalNotContains = errors.New("expected interval containing")
+)
+
+// An IntervalMatcher matches ISO 8601 time intervals.
+//
+// Supported forms are "<start>/<end>", "<start>/<duration>" and "<duration>/<end>"
+// where start and end are RFC 3339 dates. Intervals may be prefixed by a repetition, e.g. "R5/".
+//
+// It supports an optional constraint checking that the first occurrence of the interval contains
+// current time, the time is provided by a clock the same way as by DateMatcher:
+//
+//	"@interval@.containsNow()"
+type IntervalMatcher struct {
+	pattern string
+	clock   ClockFn
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *IntervalMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *IntervalMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return false, ErrNotInterval
+	}
+	start, end, ok := parseInterval(s)
+	if !ok {
+		return false, ErrNotInterval
+	}
+	for _, c := range calls {
+		switch c.name {
+		case "containsNow":
+			if len(c.args) != 0 {
+				return false, errConstraintArgs(c, 0)
+			}
+			now := m.clock()
+			if now.Before(start) || now.After(end) {
+				return false, fmt.Errorf("%w %s", ErrIntervalNotContains, now.Format(time.RFC3339))
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *IntervalMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		return validateFlagCall(c, "containsNow")
+	})
+}
+
+// Clock sets function used to get current time, time.Now is used by default.
+func (m *IntervalMatcher) Clock(c ClockFn) {
+	m.clock = c
+}
+
+func parseInterval(s string) (time.Time, time.Time, bool) {
+	parts := strings.Split(s, "/")
+	if len(parts) == 3 && intervalRepeatRe.MatchString(parts[0]) {
+		parts = parts[1:]
+	}
+	if len(parts) != 2 {
+		return time.Time{}, time.Time{}, false
+	}
+	start, startErr := time.Parse(time.RFC3339, parts[0])
+	end, endErr := time.Parse(time.RFC3339, parts[1])
+	switch {
+	case startErr == nil && endErr == nil:
+	case startErr == nil:
+		d, ok := parseISODuration(parts[1])
+		if !ok || d < 0 {
+			return time.Time{}, time.Time{}, false
+		}
+		end = start.Add(d)
+	case endErr == nil:
+		d, ok := parseISODuration(parts[0])
+		if !ok || d < 0 {
+			return time.Time{}, time.Time{}, false
+		}
+		start = end.Add(-d)
+	default:
+		return time.Time{}, time.Time{}, false
+	}
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, false
+	}
+	return start, end, true
+}
+
+// NewIntervalMatcher creates IntervalMatcher.
+func NewIntervalMatcher(pattern string) *IntervalMatcher {
+	return &IntervalMatcher{pattern, time.Now}
+}
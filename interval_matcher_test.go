@@ -0,0 +1,105 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var intervalMatcherTests = []struct {
+	desc string
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match start and end",
+		"@pattern@",
+		"2024-01-01T00:00:00Z/2024-02-01T00:00:00Z",
+		true,
+		nil,
+	},
+	{
+		"Should match start and duration",
+		"@pattern@",
+		"2024-01-01T00:00:00Z/P1M",
+		true,
+		nil,
+	},
+	{
+		"Should match duration and end",
+		"@pattern@",
+		"PT1H/2024-01-01T00:00:00Z",
+		true,
+		nil,
+	},
+	{
+		"Should match repeating interval",
+		"@pattern@",
+		"R5/2024-01-01T00:00:00Z/PT1H",
+		true,
+		nil,
+	},
+	{
+		"Should not match end before start",
+		"@pattern@",
+		"2024-02-01T00:00:00Z/2024-01-01T00:00:00Z",
+		false,
+		ErrNotInterval,
+	},
+	{
+		"Should not match two durations",
+		"@pattern@",
+		"PT1H/PT2H",
+		false,
+		ErrNotInterval,
+	},
+	{
+		"Should not match date",
+		"@pattern@",
+		"2024-01-01T00:00:00Z",
+		false,
+		ErrNotInterval,
+	},
+	{
+		"Should not match number",
+		"@pattern@",
+		1,
+		false,
+		ErrNotInterval,
+	},
+}
+
+func TestIntervalMatcher(t *testing.T) {
+	for _, tt := range intervalMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewIntervalMatcher("@pattern@")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
+
+func TestIntervalMatcherContainsNow(t *testing.T) {
+	m := NewIntervalMatcher("@interval@")
+	m.Clock(func() time.Time { return time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC) })
+
+	ok, err := m.Match("@interval@.containsNow()", "2024-01-01T00:00:00Z/P1M")
+	assert.True(t, ok)
+	assert.Nil(t, err)
+
+	ok, err = m.Match("@interval@.containsNow()", "2024-01-01T00:00:00Z/P1W")
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrIntervalNotContains))
+}
@@ -0,0 +1,129 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+var (
+	ErrNotIP       = errors.New("expected IP address")
+	ErrNotIPv4     = errors.New("expected IPv4 address")
+	ErrNotIPv6     = errors.New("expected IPv6 address")
+	ErrNotInSubnet = errors.New("expected address in subnet")
+)
+
+// An IPMatcher matches IP addresses.
+//
+// It supports an optional subnet constraint:
+//
+//	"@ip@.inSubnet('10.0.0.0/8')"
+type IPMatcher struct {
+	pattern string
+	version int
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *IPMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *IPMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return false, m.errNotIP()
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return false, m.errNotIP()
+	}
+	if (m.version == 4 && !addr.Is4()) || (m.version == 6 && !addr.Is6()) {
+		return false, m.errNotIP()
+	}
+	for _, c := range calls {
+		switch c.name {
+		case "inSubnet":
+			unmapped := addr.Unmap().WithZone("")
+			if err := matchInSubnet(c, unmapped, unmapped.BitLen()); err != nil {
+				return false, err
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *IPMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, validateSubnetCall)
+}
+
+func (m *IPMatcher) errNotIP() error {
+	switch m.version {
+	case 4:
+		return ErrNotIPv4
+	case 6:
+		return ErrNotIPv6
+	}
+	return ErrNotIP
+}
+
+// matchInSubnet checks that an address, or a prefix of given bits, lies within any of subnets
+// given as constraint arguments.
+func matchInSubnet(c patternCall, addr netip.Addr, bits int) error {
+	subnets, err := parseSubnets(c)
+	if err != nil {
+		return err
+	}
+	for _, subnet := range subnets {
+		if subnet.Contains(addr) && bits >= subnet.Bits() {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w %v", ErrNotInSubnet, c.args)
+}
+
+func parseSubnets(c patternCall) ([]netip.Prefix, error) {
+	if len(c.args) == 0 {
+		return nil, errConstraintArgs(c, 1)
+	}
+	subnets := []netip.Prefix{}
+	for _, arg := range c.args {
+		subnet, err := netip.ParsePrefix(arg)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid subnet %q", ErrInvalidPattern, arg)
+		}
+		subnets = append(subnets, subnet)
+	}
+	return subnets, nil
+}
+
+// validateSubnetCall validates constraint c of patterns matching addresses or subnets.
+func validateSubnetCall(c patternCall) error {
+	if c.name != "inSubnet" {
+		return errUnknownConstraint(c)
+	}
+	_, err := parseSubnets(c)
+	return err
+}
+
+// NewIPMatcher creates IPMatcher which matches both IPv4 and IPv6 addresses.
+func NewIPMatcher(pattern string) *IPMatcher {
+	return &IPMatcher{pattern, 0}
+}
+
+// NewIPv4Matcher creates IPMatcher which matches IPv4 addresses only.
+func NewIPv4Matcher(pattern string) *IPMatcher {
+	return &IPMatcher{pattern, 4}
+}
+
+// NewIPv6Matcher creates IPMatcher which matches IPv6 addresses only.
+func NewIPv6Matcher(pattern string) *IPMatcher {
+	return &IPMatcher{pattern, 6}
+}
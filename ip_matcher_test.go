@@ -0,0 +1,131 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ipMatcherTests = []struct {
+	desc string
+	m    *IPMatcher
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match IPv4",
+		NewIPMatcher("@ip@"),
+		"@ip@",
+		"192.168.1.5",
+		true,
+		nil,
+	},
+	{
+		"Should match IPv6",
+		NewIPMatcher("@ip@"),
+		"@ip@",
+		"2001:db8::1",
+		true,
+		nil,
+	},
+	{
+		"Should not match invalid IP",
+		NewIPMatcher("@ip@"),
+		"@ip@",
+		"192.168.1.256",
+		false,
+		ErrNotIP,
+	},
+	{
+		"Should not match if value is not a string",
+		NewIPMatcher("@ip@"),
+		"@ip@",
+		123,
+		false,
+		ErrNotIP,
+	},
+	{
+		"Should not match IPv6 with IPv4 matcher",
+		NewIPv4Matcher("@ipv4@"),
+		"@ipv4@",
+		"::1",
+		false,
+		ErrNotIPv4,
+	},
+	{
+		"Should not match IPv4 with IPv6 matcher",
+		NewIPv6Matcher("@ipv6@"),
+		"@ipv6@",
+		"127.0.0.1",
+		false,
+		ErrNotIPv6,
+	},
+	{
+		"Should match IP in subnet",
+		NewIPMatcher("@ip@"),
+		`@ip@.inSubnet("10.0.0.0/8")`,
+		"10.20.30.40",
+		true,
+		nil,
+	},
+	{
+		"Should match IP in any of subnets",
+		NewIPMatcher("@ip@"),
+		`@ip@.inSubnet('10.0.0.0/8', '192.168.0.0/16')`,
+		"192.168.1.1",
+		true,
+		nil,
+	},
+	{
+		"Should match IPv4-mapped IPv6 address in IPv4 subnet",
+		NewIPMatcher("@ip@"),
+		`@ip@.inSubnet("10.0.0.0/8")`,
+		"::ffff:10.1.1.1",
+		true,
+		nil,
+	},
+	{
+		"Should not match IP outside of subnet",
+		NewIPMatcher("@ip@"),
+		`@ip@.inSubnet("10.0.0.0/8")`,
+		"11.0.0.1",
+		false,
+		ErrNotInSubnet,
+	},
+	{
+		"Should fail on invalid subnet",
+		NewIPMatcher("@ip@"),
+		`@ip@.inSubnet("10.0.0.0")`,
+		"10.0.0.1",
+		false,
+		ErrInvalidPattern,
+	},
+	{
+		"Should fail on unknown constraint",
+		NewIPMatcher("@ip@"),
+		`@ip@.inRange("10.0.0.0/8")`,
+		"10.0.0.1",
+		false,
+		ErrInvalidPattern,
+	},
+}
+
+func TestIPMatcher(t *testing.T) {
+	for _, tt := range ipMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.True(t, tt.m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := tt.m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
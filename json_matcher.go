@@ -52,7 +52,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
+	"maps"
 	"reflect"
+	"slices"
 )
 
 var (
@@ -76,6 +79,38 @@ const (
 	patternDate      = "@date@"
 	patternEmpty     = "@empty@"
 	patternUnbounded = "@...@"
+	patternAssert    = "@assert@"
+	patternIP        = "@ip@"
+	patternIPv4      = "@ipv4@"
+	patternIPv6      = "@ipv6@"
+	patternCIDR      = "@cidr@"
+	patternMAC       = "@mac@"
+	patternHostname  = "@hostname@"
+	patternPort      = "@port@"
+	patternULID      = "@ulid@"
+	patternKSUID     = "@ksuid@"
+	patternNanoID    = "@nanoid@"
+	patternSnowflake = "@snowflake@"
+	patternObjectID  = "@objectid@"
+	patternSemver    = "@semver@"
+	patternDuration  = "@duration@"
+	patternInterval  = "@interval@"
+	patternCurrency  = "@currency@"
+	patternCountry   = "@country@"
+	patternLocale    = "@locale@"
+	patternIBAN      = "@iban@"
+	patternPAN       = "@pan@"
+	patternLatitude  = "@latitude@"
+	patternLongitude = "@longitude@"
+	patternGeoJSON   = "@geojson@"
+	patternHex       = "@hex@"
+	patternSHA256    = "@sha256@"
+	patternSHA1      = "@sha1@"
+	patternMD5       = "@md5@"
+	patternPhone     = "@phone@"
+	patternExpr      = "expr"
+	patternCI        = "ci"
+	patternTrim      = "trim"
 )
 
 // A ValueMatcher interface should be implemented by any matcher used by JSONMatcher.
@@ -88,6 +123,38 @@ type ValueMatcher interface {
 	Match(p, v interface{}) (bool, error)
 }
 
+// A MatchContext describes where a matched value is located.
+type MatchContext struct {
+	// Path is a path of the value in the actual JSON.
+	Path []interface{}
+
+	// Root is the whole actual JSON.
+	Root interface{}
+
+	// PathFormat is the format of paths set with FormatPaths, nil for the default format.
+	PathFormat PathFormat
+}
+
+// A ContextValueMatcher interface may be implemented by a ValueMatcher which needs to know
+// where the matched value is located, e.g. to compare it with other values of the document.
+// JSONMatcher uses MatchContext instead of Match when value matcher implements this interface.
+type ContextValueMatcher interface {
+	ValueMatcher
+
+	// MatchContext performs the matching of given value v located by ctx.
+	MatchContext(p, v interface{}, ctx MatchContext) (bool, error)
+}
+
+// A PatternValidator interface may be implemented by a ValueMatcher accepting patterns with arguments
+// or constraints, e.g. `@ip@.inSubnet("10.0.0.0/8")`. Lint uses ValidatePattern to validate them without
+// matching any value, patterns with constraints of other matchers are reported as ErrUnvalidatedPattern.
+type PatternValidator interface {
+	ValueMatcher
+
+	// ValidatePattern returns ErrInvalidPattern error if pattern p has unknown constraints or invalid arguments.
+	ValidatePattern(p string) error
+}
+
 // NewDefaultJSONMatcher creates JSONMatcher with default chain of value matchers.
 // Default chain contains:
 //
@@ -108,31 +175,125 @@ type ValueMatcher interface {
 // - EmptyMatcher handling "@empty@" pattern
 //
 // - WildcardMatcher handling "@wildcard@" pattern
+//
+// - IPMatcher handling "@ip@", "@ipv4@" and "@ipv6@" patterns
+//
+// - CIDRMatcher handling "@cidr@" pattern
+//
+// - MACMatcher handling "@mac@" pattern
+//
+// - HostnameMatcher handling "@hostname@" pattern
+//
+// - PortMatcher handling "@port@" pattern
+//
+// - ULIDMatcher handling "@ulid@" pattern
+//
+// - KSUIDMatcher handling "@ksuid@" pattern
+//
+// - NanoIDMatcher handling "@nanoid@" pattern
+//
+// - SnowflakeMatcher handling "@snowflake@" pattern
+//
+// - ObjectIDMatcher handling "@objectid@" pattern
+//
+// - SemverMatcher handling "@semver@" pattern
+//
+// - DurationMatcher handling "@duration@" pattern
+//
+// - IntervalMatcher handling "@interval@" pattern
+//
+// - CurrencyMatcher handling "@currency@" pattern
+//
+// - CountryMatcher handling "@country@" pattern
+//
+// - LocaleMatcher handling "@locale@" pattern
+//
+// - IBANMatcher handling "@iban@" pattern
+//
+// - PANMatcher handling "@pan@" pattern
+//
+// - CoordinateMatcher handling "@latitude@" and "@longitude@" patterns
+//
+// - GeoJSONMatcher handling "@geojson@" pattern
+//
+// - HexMatcher handling "@hex@" pattern
+//
+// - DigestMatcher handling "@sha256@", "@sha1@" and "@md5@" patterns
+//
+// - PhoneMatcher handling "@phone@" pattern
+//
+// - ExprMatcher handling "@expr(...)@" pattern
+//
+// - NormalizedStringMatcher handling "@ci(...)@" and "@trim(...)@" patterns
 func NewDefaultJSONMatcher() *JSONMatcher {
-	return NewJSONMatcher(
-		NewChainMatcher(
-			[]ValueMatcher{
-				NewStringMatcher(patternString),
-				NewNumberMatcher(patternNumber),
-				NewBoolMatcher(patternBool),
-				NewArrayMatcher(patternArray),
-				NewUUIDMatcher(patternUUID),
-				NewEmailMatcher(patternEmail),
-				NewDateMatcher(patternDate),
-				NewEmptyMatcher(patternEmpty),
-				NewWildcardMatcher(patternWildcard),
-			},
-		))
+	return NewJSONMatcher(NewChainMatcher(defaultValueMatchers()))
+}
+
+func defaultValueMatchers() []ValueMatcher {
+	return []ValueMatcher{
+		NewStringMatcher(patternString),
+		NewNumberMatcher(patternNumber),
+		NewBoolMatcher(patternBool),
+		NewArrayMatcher(patternArray),
+		NewUUIDMatcher(patternUUID),
+		NewEmailMatcher(patternEmail),
+		NewDateMatcher(patternDate),
+		NewEmptyMatcher(patternEmpty),
+		NewWildcardMatcher(patternWildcard),
+		NewIPMatcher(patternIP),
+		NewIPv4Matcher(patternIPv4),
+		NewIPv6Matcher(patternIPv6),
+		NewCIDRMatcher(patternCIDR),
+		NewMACMatcher(patternMAC),
+		NewHostnameMatcher(patternHostname),
+		NewPortMatcher(patternPort),
+		NewULIDMatcher(patternULID),
+		NewKSUIDMatcher(patternKSUID),
+		NewNanoIDMatcher(patternNanoID),
+		NewSnowflakeMatcher(patternSnowflake),
+		NewObjectIDMatcher(patternObjectID),
+		NewSemverMatcher(patternSemver),
+		NewDurationMatcher(patternDuration),
+		NewIntervalMatcher(patternInterval),
+		NewCurrencyMatcher(patternCurrency),
+		NewCountryMatcher(patternCountry),
+		NewLocaleMatcher(patternLocale),
+		NewIBANMatcher(patternIBAN),
+		NewPANMatcher(patternPAN),
+		NewLatitudeMatcher(patternLatitude),
+		NewLongitudeMatcher(patternLongitude),
+		NewGeoJSONMatcher(patternGeoJSON),
+		NewHexMatcher(patternHex),
+		NewSHA256Matcher(patternSHA256),
+		NewSHA1Matcher(patternSHA1),
+		NewMD5Matcher(patternMD5),
+		NewPhoneMatcher(patternPhone),
+		NewExprMatcher(patternExpr),
+		NewCIMatcher(patternCI),
+		NewTrimMatcher(patternTrim),
+	}
 }
 
 // NewJSONMatcher creates JSONMatcher with given value matcher.
 func NewJSONMatcher(matcher ValueMatcher) *JSONMatcher {
-	return &JSONMatcher{matcher}
+	return &JSONMatcher{valueMatcher: matcher}
 }
 
 // A JSONMatcher provides Match method to match two JSONs with pattern matching support.
 type JSONMatcher struct {
 	valueMatcher ValueMatcher
+	fragments    map[string]interface{}
+	includeFS    fs.FS
+	stringMode   StringMode
+	keyMode      StringMode
+	pathFormat   PathFormat
+	expectedFile string
+	actualFile   string
+	strict       bool
+	marshaler    JSONMarshalFn
+
+	maxErrors      int
+	maxValueLength int
 }
 
 // Match performs deep match of given JSON with an expected JSON pattern.
@@ -182,117 +343,310 @@ type JSONMatcher struct {
 //		"@...@": ""
 //	}
 //
+// Objects may define invariants spanning multiple fields with an "@assert@" key.
+// Its value is an expression or a list of expressions, see ExprMatcher for the expression language.
+// Identifiers refer to fields of the actual object, "root" refers to the whole actual JSON:
+//
+//	{
+//		"startDate": "@date@",
+//		"endDate": "@date@",
+//		"count": "@number@",
+//		"items": "@array@",
+//		"@assert@": ["date(endDate) > date(startDate)", "count == len(items)"]
+//	}
+//
+// Heterogeneous objects, e.g. events or polymorphic resources, may be matched with a discriminated union
+// "@union(discriminator, branches)@". The branch is selected by the discriminator field of the actual object
+// and the object is matched with it. Branches are given as a JSON object:
+//
+//	[
+//		"@union('type', {\"card\": {\"number\": \"@pan@\"}, \"bank\": {\"iban\": \"@iban@\"}})@",
+//		"@...@"
+//	]
+//
+// Patterns registered with RegisterFragment may be referenced as "@ref(name)@".
+// Patterns stored in files may be included as "@include('fixtures/user.json')@", see IncludeFS.
+//
+// String values and object keys may be compared case-insensitively or ignoring whitespace,
+// see CompareStrings and CompareKeys.
+//
+// Use MatchParams to substitute "${name}" placeholders in expected JSON
+// and MatchResult to get mismatches as structured records.
+//
 // When matching fails then error message contains a path to invalid value.
+// Errors are reported in a stable order, object keys are visited in sorted order.
 func (m *JSONMatcher) Match(expectedJSON, actualJSON string) (bool, error) {
+	return m.MatchParams(expectedJSON, actualJSON, nil)
+}
+
+func (s *matchState) formatPath(path []interface{}) string {
+	return formatPath(s.pathFormat, path)
+}
+
+// decode decodes JSONs and keeps their sources in match state, so positions of mismatching values can be found.
+func (m *JSONMatcher) decode(s *matchState, expectedJSON, actualJSON []byte, params ParamResolver) (interface{}, interface{}, error) {
 	var expected, actual interface{}
-	err := json.Unmarshal([]byte(expectedJSON), &expected)
+	err := json.Unmarshal(expectedJSON, &expected)
 	if err != nil {
-		return false, errInvalidJSONPattern
+		return nil, nil, errInvalidJSONPattern
 	}
-	err = json.Unmarshal([]byte(actualJSON), &actual)
+	if m.strict {
+		// Pattern is linted with parameters substituted, unresolved parameters are reported by matching.
+		pattern := expected
+		if params != nil {
+			pattern, err = substituteParams(expected, params, nil)
+		}
+		errs := []error{}
+		if err == nil {
+			for _, d := range m.lintValue(pattern, expectedJSON) {
+				errs = append(errs, d)
+			}
+		}
+		if len(errs) > 0 {
+			return nil, nil, errors.Join(errs...)
+		}
+	}
+	err = json.Unmarshal(actualJSON, &actual)
 	if err != nil {
-		return false, errInvalidJSON
+		return nil, nil, errInvalidJSON
+	}
+	s.expectedJSON, s.actualJSON = expectedJSON, actualJSON
+	return expected, actual, nil
+}
+
+// matchDecoded matches decoded JSONs, substituting parameters first if params are given.
+func (m *JSONMatcher) matchDecoded(s *matchState, expected, actual interface{}, params ParamResolver) error {
+	s.root, s.pathFormat, s.maxErrors, s.params = actual, m.pathFormat, m.maxErrors, params
+	expected, err := s.substituteParams(expected, nil)
+	if err == nil {
+		err = m.deepMatch(s, expected, actual, nil)
+	}
+	if m.maxErrors > 0 && countErrors(err) > m.maxErrors {
+		s.truncated = true
+		err = limitErrors(err, m.maxErrors)
 	}
-	err = m.deepMatch(expected, actual, nil)
 	if err != nil {
-		return false, err
+		err = withPositions(err, scanPositions(s.expectedJSON, m.expectedFile), 0, scanPositions(s.actualJSON, m.actualFile))
+	}
+	if m.pathFormat != nil {
+		err = withPathFormat(err, m.pathFormat)
 	}
-	return true, nil
+	if m.maxValueLength > 0 {
+		err = mapErrors(err, func(e ErrGomatch) ErrGomatch {
+			e.maxValueLength = m.maxValueLength
+			return e
+		})
+	}
+	return err
 }
 
-func (m *JSONMatcher) deepMatch(expected interface{}, actual interface{}, path []interface{}) error {
+// matchState holds data of a single Match call.
+type matchState struct {
+	root interface{}
+
+	// refs are fragments expanded for the value at path of refsDepth length.
+	refs      []string
+	refsDepth int
+
+	// includes are files being included, included caches their patterns.
+	includes []string
+	included map[string]includedFile
+
+	pathFormat PathFormat
+
+	// params are substituted in the expected JSON and in patterns expanded from fragments and included files.
+	params ParamResolver
+
+	// expectedJSON and actualJSON are scanned for positions of values only if matching fails.
+	expectedJSON, actualJSON []byte
+
+	// errorCount is number of errors found so far, matching stops when it exceeds maxErrors.
+	errorCount int
+	maxErrors  int
+	truncated  bool
+
+	// stats are collected always, captures only when requested by MatchResult.
+	stats    Stats
+	captures map[string]interface{}
+}
+
+func (m *JSONMatcher) deepMatch(s *matchState, expected interface{}, actual interface{}, path []interface{}) error {
+	if u, ok, err := parseUnion(expected); ok {
+		if err != nil {
+			return NewErrGomatch(err, path, expected, actual, "")
+		}
+		return m.matchUnion(s, u, expected, actual, path)
+	}
+	if name, each, ok := parseRef(expected); ok {
+		return m.matchRef(s, name, each, expected, actual, path)
+	}
+	if name, ok, err := includeName(expected); ok {
+		if err != nil {
+			return NewErrGomatch(err, path, expected, actual, "")
+		}
+		return m.matchInclude(s, name, expected, actual, path)
+	}
 	if reflect.TypeOf(expected) != reflect.TypeOf(actual) && !m.valueMatcher.CanMatch(expected) {
 		return NewErrGomatch(ErrTypesNotEqual, path, expected, actual, "")
 	}
 
 	switch expected.(type) {
 	case []interface{}:
-		return m.deepMatchArray(expected.([]interface{}), actual.([]interface{}), path)
+		s.stats.Arrays++
+		return m.deepMatchArray(s, expected.([]interface{}), actual.([]interface{}), path)
 
 	case map[string]interface{}:
-		return m.deepMatchMap(expected.(map[string]interface{}), actual.(map[string]interface{}), path)
+		s.stats.Objects++
+		return m.deepMatchMap(s, expected.(map[string]interface{}), actual.(map[string]interface{}), path)
 
 	default:
-		return m.matchValue(expected, actual, path)
+		return m.matchValue(s, expected, actual, path)
 	}
 }
 
-func (m *JSONMatcher) deepMatchArray(expected, actual, path []interface{}) error {
+func (m *JSONMatcher) deepMatchArray(s *matchState, expected, actual, path []interface{}) error {
 	unbounded := false
-	errs := []error{}
+	errs := s.collectErrors()
 	for i, v := range expected {
 		if isUnbounded(v) {
 			unbounded = true
 			break
 		}
-		if i == len(actual) {
+		if i == len(actual) || errs.full() {
 			break
 		}
-		errs = append(errs, m.deepMatch(v, actual[i], append(path, i)))
+		errs.add(m.deepMatch(s, v, actual[i], appendPath(path, i)))
 	}
-	if !unbounded && len(expected) != len(actual) {
-		errs = append(errs, NewErrGomatch(errArraysLenNotEqual, path, expected, actual, ""))
+	if !unbounded && len(expected) != len(actual) && !errs.full() {
+		errs.add(NewErrGomatch(errArraysLenNotEqual, path, expected, actual, ""))
 	}
-	return errors.Join(errs...)
+	return errs.err()
 }
 
-func (m *JSONMatcher) deepMatchMap(expected, actual map[string]interface{}, path []interface{}) error {
+func (m *JSONMatcher) deepMatchMap(s *matchState, expected, actual map[string]interface{}, path []interface{}) error {
 	unbounded := false
-	errs := []error{}
-	for k, v1 := range expected {
+	errs := s.collectErrors()
+	missing := []string{}
+	missingErrs := map[string]int{}
+	pairs := m.keyMode.pairKeys(expected, actual)
+	for _, k := range slices.Sorted(maps.Keys(expected)) {
+		v1 := expected[k]
 		if isUnbounded(k) {
 			unbounded = true
 			continue
 		}
-		v2, ok := actual[k]
+		if isAssert(k) {
+			continue
+		}
+		if errs.full() {
+			break
+		}
+		if keys, ok := pairs.ambiguous[k]; ok {
+			found := map[string]interface{}{}
+			for _, ak := range keys {
+				found[ak] = actual[ak]
+			}
+			err := fmt.Errorf("%w %q: matches %s", ErrAmbiguousKey, k, quoteKeys(keys))
+			errs.add(NewErrGomatch(err, path, v1, found, k))
+			continue
+		}
+		ak, ok := pairs.paired[k]
 		if !ok {
+			missing, missingErrs[k] = append(missing, k), len(errs.errs)
 			if m.valueMatcher.CanMatch(v1) {
-				_, err := m.valueMatcher.Match(v1, nil)
+				_, err := m.matchPattern(s, v1, nil, appendPath(path, k))
 				if err != nil {
-					errs = append(errs, NewErrGomatch(err, append(path, k), v1, nil, k))
+					errs.add(NewErrGomatch(err, appendPath(path, k), v1, nil, k))
 					continue
 				}
-				actual[k] = nil
+				missing = missing[:len(missing)-1]
+				actual[k], pairs.used[k] = nil, true
 				continue
 			}
-			errs = append(errs, NewErrGomatch(fmt.Errorf("%w %q", ErrMissingKey, k), path, v1, nil, k))
+			errs.add(NewErrGomatch(fmt.Errorf("%w %q", ErrMissingKey, k), path, v1, nil, k))
 		} else {
-			err := m.deepMatch(v1, v2, append(path, k))
-			if err != nil {
-				errs = append(errs, err)
-				continue
+			err := m.deepMatch(s, v1, actual[ak], appendPath(path, ak))
+			if ak != k {
+				err = withExpectedKey(err, len(path), k)
 			}
+			errs.add(err)
 		}
 	}
+	unexpected := []string{}
+	for _, k := range slices.Sorted(maps.Keys(actual)) {
+		if !pairs.used[k] {
+			unexpected = append(unexpected, k)
+		}
+	}
+	renamedTo := map[string]bool{}
+	for k, ak := range renamedKeys(missing, unexpected) {
+		err := fmt.Errorf("%w %q: did you mean %q?", ErrRenamedKey, k, ak)
+		errs.errs[missingErrs[k]] = NewErrGomatch(err, path, expected[k], actual[ak], k)
+		renamedTo[ak] = true
+	}
 	if !unbounded {
-		for k, val := range actual {
-			if _, ok := expected[k]; ok {
-				continue
-			} else {
-				errs = append(errs, NewErrGomatch(fmt.Errorf("%w %q", ErrUnexpectedKey, k), path, nil, val, k))
+		for _, k := range unexpected {
+			if !renamedTo[k] && !errs.full() {
+				errs.add(NewErrGomatch(fmt.Errorf("%w %q", ErrUnexpectedKey, k), path, nil, actual[k], k))
 			}
 		}
-		// errs = append(errs, NewErrGomatch(errUnexpectedKey, path, expected, actual, ""))
 	}
-	return errors.Join(errs...)
+	if assertions, ok := expected[patternAssert]; ok && !errs.full() {
+		errs.add(m.matchAssertions(s, assertions, actual, path)...)
+	}
+	return errs.err()
 }
 
-func (m *JSONMatcher) matchValue(expected, actual interface{}, path []interface{}) error {
+func (m *JSONMatcher) matchValue(s *matchState, expected, actual interface{}, path []interface{}) error {
+	s.stats.Values++
 	if m.valueMatcher.CanMatch(expected) {
-		_, err := m.valueMatcher.Match(expected, actual)
+		_, err := m.matchPattern(s, expected, actual, path)
 		return NewErrGomatch(err, path, expected, actual, "")
 	}
+	if es, ok := expected.(string); ok && m.stringMode != 0 {
+		if as, ok := actual.(string); ok && m.stringMode.equal(es, as) {
+			return nil
+		}
+	}
 	if expected != actual {
+		if err := m.suggestPattern(expected); err != nil {
+			return NewErrGomatch(err, path, expected, actual, "")
+		}
 		return NewErrGomatch(errValuesNotEqual, path, expected, actual, "")
 	}
 	return nil
 }
 
+func (m *JSONMatcher) matchPattern(s *matchState, p, v interface{}, path []interface{}) (bool, error) {
+	s.stats.Patterns++
+	var ok bool
+	var err error
+	if cm, isContext := m.valueMatcher.(ContextValueMatcher); isContext {
+		ok, err = cm.MatchContext(p, v, MatchContext{Path: slices.Clone(path), Root: s.root, PathFormat: s.pathFormat})
+	} else {
+		ok, err = m.valueMatcher.Match(p, v)
+	}
+	if err == nil && s.captures != nil {
+		s.captures[s.formatPath(path)] = v
+	}
+	return ok, err
+}
+
 func isUnbounded(p interface{}) bool {
 	return isPattern(p, patternUnbounded)
 }
 
+func isAssert(p interface{}) bool {
+	return isPattern(p, patternAssert)
+}
+
 func isPattern(p interface{}, pattern string) bool {
 	ps, ok := p.(string)
 	return ok && ps == pattern
 }
+
+// appendPath returns a copy of path with element e appended,
+// so paths of sibling values do not share the underlying array.
+func appendPath(path []interface{}, e interface{}) []interface{} {
+	return append(path[:len(path):len(path)], e)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"testing/fstest"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -529,3 +530,533 @@ func TestJSONMatcherWithErrorReportingValues(t *testing.T) {
 	assert.True(t, strings.Contains(errText, `unexpected key "unexpectedMap" at ".deep.nested". expected: null, provided: {"missing_map":"this_will_miss"}`))
 
 }
+
+func TestJSONMatcherWithNetworkMatchers(t *testing.T) {
+	p := `
+	{
+		"ip": "@ip@",
+		"ipv4": "@ipv4@.inSubnet('10.0.0.0/8')",
+		"ipv6": "@ipv6@",
+		"cidr": "@cidr@",
+		"mac": "@mac@",
+		"host": "@hostname@",
+		"port": "@port@"
+	}
+	`
+	v := `
+	{
+		"ip": "2001:db8::1",
+		"ipv4": "10.1.2.3",
+		"ipv6": "fe80::1",
+		"cidr": "192.168.0.0/16",
+		"mac": "00:1a:2b:3c:4d:5e",
+		"host": "api.example.com",
+		"port": 8080
+	}
+	`
+
+	m := NewDefaultJSONMatcher()
+	ok, err := m.Match(p, v)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.Match(p, strings.Replace(v, "10.1.2.3", "172.16.0.1", 1))
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrNotInSubnet))
+	assert.True(t, strings.Contains(err.Error(), `expected address in subnet [10.0.0.0/8] at ".ipv4"`))
+}
+
+func TestJSONMatcherWithAssertions(t *testing.T) {
+	p := `
+	{
+		"startDate": "@date@",
+		"endDate": "@date@",
+		"count": "@number@",
+		"total": "@number@",
+		"items": [{"price": "@number@"}, "@...@"],
+		"@assert@": ["date(endDate) > date(startDate)", "count == len(items)", "total == sum(items[*].price)"]
+	}
+	`
+	v := `
+	{
+		"startDate": "2024-01-01T00:00:00Z",
+		"endDate": "2024-01-02T00:00:00Z",
+		"count": 2,
+		"total": 30,
+		"items": [{"price": 10}, {"price": 20}]
+	}
+	`
+
+	m := NewDefaultJSONMatcher()
+	ok, err := m.Match(p, v)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	v = `
+	{
+		"startDate": "2024-01-01T20:00:00Z",
+		"endDate": "2024-01-02T00:00:00+05:00",
+		"count": 3,
+		"total": 30,
+		"items": [{"price": 10}, {"price": 20}]
+	}
+	`
+	ok, err = m.Match(p, v)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrAssertionFailed))
+
+	errText := err.Error()
+	assert.Contains(t, errText, `assertion failed "date(endDate) > date(startDate)" involving [".endDate" ".startDate"] at "."`)
+	assert.Contains(t, errText, `provided: {"endDate":"2024-01-02T00:00:00+05:00","startDate":"2024-01-01T20:00:00Z"}`)
+	assert.Contains(t, errText, `assertion failed "count == len(items)" involving [".count" ".items"] at "."`)
+	assert.NotContains(t, errText, `total == sum(items[*].price)`)
+}
+
+func TestJSONMatcherWithNestedAssertions(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+
+	ok, err := m.Match(
+		`{"limit": 5, "page": {"size": "@number@", "@assert@": "size <= root.limit"}}`,
+		`{"limit": 5, "page": {"size": 10}}`,
+	)
+	assert.False(t, ok)
+	assert.Contains(t, err.Error(), `assertion failed "size <= root.limit" involving [".page.size"] at ".page"`)
+
+	ok, err = m.Match(`{"root": "@number@", "@assert@": "root == 1"}`, `{"root": 1}`)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+
+	ok, err = m.Match(`{"a": 1, "@assert@": "a >"}`, `{"a": 1}`)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrInvalidPattern))
+}
+
+func TestJSONMatcherWithUnions(t *testing.T) {
+	p := `
+	[
+		"@union('type', {\"card\": {\"number\": \"@pan@\"}, \"bank\": {\"iban\": \"@iban@\"}, \"cash\": \"@wildcard@\"})@",
+		"@union('type', {\"card\": {\"number\": \"@pan@\"}, \"bank\": {\"iban\": \"@iban@\"}, \"cash\": \"@wildcard@\"})@",
+		"@union('type', {\"card\": {\"number\": \"@pan@\"}, \"bank\": {\"iban\": \"@iban@\"}, \"cash\": \"@wildcard@\"})@"
+	]
+	`
+	v := `
+	[
+		{"type": "card", "number": "4111111111111111"},
+		{"type": "bank", "iban": "SK3112000000198742637541"},
+		{"type": "cash", "amount": 10}
+	]
+	`
+
+	m := NewDefaultJSONMatcher()
+	ok, err := m.Match(p, v)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	tests := []struct {
+		desc    string
+		v       string
+		err     error
+		errText string
+	}{
+		{
+			"branch mismatch",
+			`[{"type": "card", "number": "4111111111111112"}, {"type": "cash"}, {"type": "cash"}]`,
+			ErrUnionBranchMismatch,
+			`mismatch in union branch "card" selected by "type": 3:3: expected card number at ".[0].number"`,
+		},
+		{
+			"unexpected key in branch",
+			`[{"type": "bank", "iban": "SK3112000000198742637541", "number": "4111111111111111"}, {"type": "cash"}, {"type": "cash"}]`,
+			ErrUnexpectedKey,
+			`mismatch in union branch "bank" selected by "type": 3:3: unexpected key "number" at ".[0]"`,
+		},
+		{
+			"unknown branch",
+			`[{"type": "crypto"}, {"type": "cash"}, {"type": "cash"}]`,
+			ErrUnionUnknownBranch,
+			`unknown union branch "crypto" of "type", expected one of ["bank" "card" "cash"] at ".[0].type"`,
+		},
+		{
+			"missing discriminator",
+			`[{"number": "4111111111111111"}, {"type": "cash"}, {"type": "cash"}]`,
+			ErrUnionDiscriminator,
+			`expected union discriminator "type" at ".[0]"`,
+		},
+		{
+			"not an object",
+			`[1, {"type": "cash"}, {"type": "cash"}]`,
+			ErrUnionDiscriminator,
+			`expected union discriminator "type" in object at ".[0]"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ok, err := m.Match(p, tt.v)
+			assert.False(t, ok)
+			assert.True(t, errors.Is(err, tt.err))
+			assert.Contains(t, err.Error(), tt.errText)
+		})
+	}
+}
+
+func TestJSONMatcherWithInvalidUnions(t *testing.T) {
+	tests := []struct {
+		desc string
+		p    string
+	}{
+		{"missing discriminator", `"@union({\"a\": 1})@"`},
+		{"unterminated discriminator", `"@union('type, {\"a\": 1})@"`},
+		{"invalid branches", `"@union('type', [1])@"`},
+	}
+
+	m := NewDefaultJSONMatcher()
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ok, err := m.Match(tt.p, `{"type": "a"}`)
+			assert.False(t, ok)
+			assert.True(t, errors.Is(err, ErrInvalidPattern))
+		})
+	}
+}
+
+func TestJSONMatcherWithFragments(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	assert.Nil(t, m.RegisterFragment("user", `{"id": "@number@", "name": "@string@"}`))
+	assert.Nil(t, m.RegisterFragment("comment", `{"author": "@ref(user)@", "text": "@string@", "replies": "@ref(comment[])@"}`))
+
+	p := `{"owner": "@ref(user)@", "comments": "@ref(comment[])@"}`
+	v := `
+	{
+		"owner": {"id": 1, "name": "John"},
+		"comments": [
+			{
+				"author": {"id": 2, "name": "Joe"},
+				"text": "first",
+				"replies": [
+					{"author": {"id": 1, "name": "John"}, "text": "reply", "replies": []}
+				]
+			}
+		]
+	}
+	`
+	ok, err := m.Match(p, v)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	v = `
+	{
+		"owner": {"id": 1, "name": "John"},
+		"comments": [
+			{
+				"author": {"id": 2, "name": "Joe"},
+				"text": "first",
+				"replies": [
+					{"author": {"id": "1", "name": "John"}, "text": "reply", "replies": []}
+				]
+			}
+		]
+	}
+	`
+	ok, err = m.Match(p, v)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, errNotNumber))
+	assert.Contains(t, err.Error(), `at ".comments[0].replies[0].author.id"`)
+
+	ok, err = m.Match(`{"owner": "@ref(group)@"}`, `{"owner": {}}`)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrUnknownFragment))
+
+	ok, err = m.Match(`{"comments": "@ref(comment[])@"}`, `{"comments": {}}`)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrNotArray))
+}
+
+func TestJSONMatcherWithFragmentCycles(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	assert.Nil(t, m.RegisterFragment("a", `"@ref(b)@"`))
+	assert.Nil(t, m.RegisterFragment("b", `"@union('type', {\"x\": \"@ref(a)@\"})@"`))
+	assert.Nil(t, m.RegisterFragment("node", `{"children": ["@ref(node)@", "@ref(node)@"]}`))
+
+	ok, err := m.Match(`"@ref(a)@"`, `{"type": "x"}`)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrFragmentCycle))
+	assert.Contains(t, err.Error(), "fragment cycle a -> b -> a")
+
+	ok, err = m.Match(`["@ref(node)@", "@ref(node)@"]`, `[{"children": []}, {"children": []}]`)
+	assert.False(t, ok)
+	assert.False(t, errors.Is(err, ErrFragmentCycle))
+	assert.True(t, errors.Is(err, errArraysLenNotEqual))
+}
+
+func TestJSONMatcherRegisterFragment(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	assert.True(t, errors.Is(m.RegisterFragment("", `{}`), ErrInvalidPattern))
+	assert.True(t, errors.Is(m.RegisterFragment("user[]", `{}`), ErrInvalidPattern))
+	assert.True(t, errors.Is(m.RegisterFragment("user", `{`), errInvalidJSONPattern))
+}
+
+func TestJSONMatcherWithIncludes(t *testing.T) {
+	fsys := fstest.MapFS{
+		"fixtures/user.json":         {Data: []byte(`{"id": "@number@", "name": "@string@", "address": "@include('address.json')@"}`)},
+		"fixtures/address.json":      {Data: []byte(`{"city": "@string@"}`)},
+		"fixtures/order.json":        {Data: []byte(`{"customer": "@include('/fixtures/user.json')@", "total": "@number@"}`)},
+		"fixtures/cycle/a.json":      {Data: []byte(`{"b": "@include('b.json')@"}`)},
+		"fixtures/cycle/b.json":      {Data: []byte(`{"a": "@include('../cycle/a.json')@"}`)},
+		"fixtures/invalid/user.json": {Data: []byte(`{"id": }`)},
+	}
+	m := NewDefaultJSONMatcher()
+	m.IncludeFS(fsys)
+
+	p := `{"order": "@include('fixtures/order.json')@", "users": ["@include(fixtures/user.json)@", "@...@"]}`
+	v := `
+	{
+		"order": {"customer": {"id": 1, "name": "John", "address": {"city": "Boston"}}, "total": 10},
+		"users": [{"id": 2, "name": "Joe", "address": {"city": "Prague"}}]
+	}
+	`
+	ok, err := m.Match(p, v)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	tests := []struct {
+		desc    string
+		p       string
+		v       string
+		err     error
+		errText string
+	}{
+		{
+			"mismatch in included file",
+			`{"user": "@include('fixtures/user.json')@"}`,
+			`{"user": {"id": 1, "name": "John", "address": {"city": 1}}}`,
+			ErrNotString,
+			`expected string at ".user.address.city" (included from fixtures/address.json)`,
+		},
+		{
+			"missing key in included file",
+			`{"user": "@include('fixtures/user.json')@"}`,
+			`{"user": {"id": 1, "name": "John"}}`,
+			ErrMissingKey,
+			`missing key "address" at ".user" (included from fixtures/user.json)`,
+		},
+		{
+			"include cycle",
+			`"@include('fixtures/cycle/a.json')@"`,
+			`{"b": {"a": {"b": {}}}}`,
+			ErrIncludeCycle,
+			`include cycle fixtures/cycle/a.json -> fixtures/cycle/b.json -> fixtures/cycle/a.json at ".b.a" (included from fixtures/cycle/b.json)`,
+		},
+		{
+			"missing file",
+			`"@include('fixtures/group.json')@"`,
+			`{}`,
+			ErrInclude,
+			`cannot include "fixtures/group.json"`,
+		},
+		{
+			"invalid file",
+			`"@include('fixtures/invalid/user.json')@"`,
+			`{}`,
+			errInvalidJSONPattern,
+			`cannot include "fixtures/invalid/user.json": invalid JSON pattern`,
+		},
+		{
+			"invalid pattern",
+			`"@include('a.json', 'b.json')@"`,
+			`{}`,
+			ErrInvalidPattern,
+			`expected single file name`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ok, err := m.Match(tt.p, tt.v)
+			assert.False(t, ok)
+			assert.True(t, errors.Is(err, tt.err))
+			assert.Contains(t, err.Error(), tt.errText)
+		})
+	}
+}
+
+func TestJSONMatcherWithParams(t *testing.T) {
+	params := ParamsMap(map[string]interface{}{
+		"userId":  351,
+		"name":    "John",
+		"limit":   100.5,
+		"tags":    []string{"a", "b"},
+		"address": struct{ City string }{"Boston"},
+		"key":     "city",
+	})
+	tests := []struct {
+		desc string
+		p    string
+		v    string
+		err  error
+	}{
+		{
+			"typed substitution",
+			`{"id": "${userId}", "name": "${name}", "tags": "${tags}", "address": "${address}"}`,
+			`{"id": 351, "name": "John", "tags": ["a", "b"], "address": {"City": "Boston"}}`,
+			nil,
+		},
+		{
+			"number does not match string",
+			`{"id": "${userId}"}`,
+			`{"id": "351"}`,
+			ErrTypesNotEqual,
+		},
+		{
+			"interpolation",
+			`{"greeting": "Hello ${name} (${userId})", "price": "@expr(value <= ${limit})@", "${key}": "@string@"}`,
+			`{"greeting": "Hello John (351)", "price": 100, "city": "Boston"}`,
+			nil,
+		},
+		{
+			"escaped placeholder",
+			`{"template": "$${name} is ${name}"}`,
+			`{"template": "${name} is John"}`,
+			nil,
+		},
+		{
+			"unresolved parameter",
+			`{"id": "${orderId}"}`,
+			`{"id": 1}`,
+			ErrUnresolvedParam,
+		},
+		{
+			"unresolved interpolated parameter",
+			`{"id": "order-${orderId}"}`,
+			`{"id": "order-1"}`,
+			ErrUnresolvedParam,
+		},
+	}
+
+	m := NewDefaultJSONMatcher()
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ok, err := m.MatchParams(tt.p, tt.v, params)
+			assert.Equal(t, tt.err == nil, ok)
+			if tt.err == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+
+	ok, err := m.MatchParams(`{"id": "${orderId}"}`, `{"id": 1}`, params)
+	assert.False(t, ok)
+	assert.Contains(t, err.Error(), `unresolved parameter "orderId" at ".id"`)
+
+	ok, err = m.Match(`{"id": "${orderId}"}`, `{"id": "${orderId}"}`)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+}
+
+func TestJSONMatcherWithParamsInFragments(t *testing.T) {
+	params := ParamsMap(map[string]interface{}{"userId": 351})
+	m := NewDefaultJSONMatcher()
+	assert.Nil(t, m.RegisterFragment("user", `{"id": "${userId}", "name": "@string@"}`))
+
+	ok, err := m.MatchParams(`{"users": "@ref(user[])@"}`, `{"users": [{"id": 351, "name": "John"}]}`, params)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.MatchParams(`{"user": "@ref(user)@"}`, `{"user": {"id": 1, "name": "John"}}`, params)
+	assert.False(t, ok)
+	assert.Contains(t, err.Error(), `values are not equal at ".user.id". expected: 351, provided: 1`)
+
+	_, err = m.MatchParams(`{"user": "@ref(user)@"}`, `{"user": {"id": 351, "name": "John"}}`, ParamsMap(nil))
+	assert.Contains(t, err.Error(), `unresolved parameter "userId" at ".user.id"`)
+}
+
+func TestJSONMatcherWithParamsInIncludes(t *testing.T) {
+	params := ParamsMap(map[string]interface{}{"userId": 351})
+	m := NewDefaultJSONMatcher()
+	m.IncludeFS(fstest.MapFS{"user.json": {Data: []byte(`{"id": "${userId}", "name": "@string@"}`)}})
+
+	ok, err := m.MatchParams(`{"user": "@include('user.json')@"}`, `{"user": {"id": 351, "name": "John"}}`, params)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.MatchParams(`{"user": "@include('user.json')@"}`, `{"user": {"id": 1, "name": "John"}}`, params)
+	assert.False(t, ok)
+	assert.Contains(t, err.Error(), `values are not equal at ".user.id" (included from user.json). expected: 351, provided: 1`)
+
+	_, err = m.MatchParams(`{"user": "@include('user.json')@"}`, `{"user": {"id": 351, "name": "John"}}`, ParamsMap(nil))
+	assert.Contains(t, err.Error(), `unresolved parameter "userId" at ".user.id" (included from user.json)`)
+}
+
+func TestJSONMatcherWithStringModes(t *testing.T) {
+	p := `{"status": "paid", "name": "Café", "address": {"city": "New York"}}`
+	v := `{"Status": " PAID ", "NAME": "cafe\u0301", "address": {"City": "new  york"}}`
+
+	m := NewDefaultJSONMatcher()
+	ok, err := m.Match(p, v)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrMissingKey))
+
+	m.CompareKeys(IgnoreCase)
+	ok, err = m.Match(p, v)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, errValuesNotEqual))
+	assert.False(t, errors.Is(err, ErrMissingKey))
+	assert.False(t, errors.Is(err, ErrUnexpectedKey))
+	assert.Contains(t, err.Error(), `values are not equal at ".Status"`)
+
+	m.CompareStrings(IgnoreCase | IgnoreWhitespace | NormalizeUnicode)
+	ok, err = m.Match(p, v)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.Match(`{"status": "paid", "@...@": ""}`, `{"status": "paid", "STATUS": "x"}`)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.Match(`{"status": "paid", "@...@": ""}`, `{"Status": "paid", "STATUS": "x"}`)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrAmbiguousKey))
+	assert.Contains(t, err.Error(), `ambiguous key "status": matches "STATUS", "Status" at ".". expected: "paid", provided: {"STATUS":"x","Status":"paid"}`)
+
+	ok, err = m.Match(`{"Name": "a", "name": "a"}`, `{"name": "a"}`)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrMissingKey))
+	assert.Contains(t, err.Error(), `missing key "Name"`)
+
+	ok, err = m.Match(`{"Name": "a", "name": "b"}`, `{"NAME": "a", "name": "b"}`)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	r, err := m.MatchResult(`{"status": "paid"}`, `{"Status": "paid", "STATUS": "paid"}`)
+	assert.Nil(t, err)
+	assert.Len(t, r.Mismatches, 1)
+	assert.Equal(t, KindAmbiguousKey, r.Mismatches[0].Kind)
+	assert.Equal(t, []interface{}{"status"}, r.Mismatches[0].Path)
+
+	m = NewDefaultJSONMatcher()
+	ok, err = m.Match(`{"status": "@ci('paid')@", "name": "@trim('John Smith')@"}`, `{"status": "Paid", "name": " John Smith "}`)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestJSONMatcherErrorOrder(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	p := `{"c": 1, "a": 1, "b": {"z": 1, "y": 1}, "e": "@string@"}`
+	v := `{"c": 2, "a": 2, "b": {"z": 2, "y": 2, "x": 2}, "d": 1}`
+
+	_, err := m.Match(p, v)
+	first := err.Error()
+	for i := 0; i < 20; i++ {
+		_, err := m.Match(p, v)
+		assert.Equal(t, first, err.Error())
+	}
+	assert.Equal(t, `1:15: values are not equal at ".a". expected: 1, provided: 2 (provided at 1:15)
+1:37: values are not equal at ".b.y". expected: 1, provided: 2 (provided at 1:37)
+1:29: values are not equal at ".b.z". expected: 1, provided: 2 (provided at 1:29)
+1:23: unexpected key "x" at ".b". expected: null, provided: 2 (provided at 1:45)
+1:7: values are not equal at ".c". expected: 1, provided: 2 (provided at 1:7)
+1:46: expected string at ".e". expected: "@string@", provided: null (provided at 1:1)
+1:1: unexpected key "d" at ".". expected: null, provided: 1 (provided at 1:54)`, first)
+}
@@ -0,0 +1,92 @@
+package gomatch
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// A JSONReporter writes results as JSON documents, one per line.
+//
+// Each document has a stable schema:
+//
+//	{
+//		"matched": false,
+//		"mismatches": [
+//			{
+//				"path": ".items[0].price",
+//				"pointer": "/items/0/price",
+//				"reason": "pattern_mismatch",
+//				"message": "expected number",
+//				"expected": "@number@",
+//				"actual": "10",
+//				"pattern": "@number@",
+//				"matcher": "NumberMatcher",
+//				"source": ""
+//			}
+//		],
+//		"stats": {"objects": 2, "arrays": 1, "values": 3, "patterns": 1}
+//	}
+//
+// The reason is one of MismatchKind values.
+type JSONReporter struct {
+	w io.Writer
+}
+
+type jsonReport struct {
+	Matched    bool            `json:"matched"`
+	Mismatches []jsonMismatch  `json:"mismatches"`
+	Stats      jsonReportStats `json:"stats"`
+}
+
+type jsonMismatch struct {
+	Path     string       `json:"path"`
+	Pointer  string       `json:"pointer"`
+	Reason   MismatchKind `json:"reason"`
+	Message  string       `json:"message"`
+	Expected interface{}  `json:"expected"`
+	Actual   interface{}  `json:"actual"`
+	Pattern  string       `json:"pattern"`
+	Matcher  string       `json:"matcher"`
+	Source   string       `json:"source"`
+}
+
+type jsonReportStats struct {
+	Objects  int `json:"objects"`
+	Arrays   int `json:"arrays"`
+	Values   int `json:"values"`
+	Patterns int `json:"patterns"`
+}
+
+// NewJSONReporter creates JSONReporter writing to w.
+func NewJSONReporter(w io.Writer) *JSONReporter {
+	return &JSONReporter{w}
+}
+
+// Report writes the result as a single line JSON document.
+func (r *JSONReporter) Report(res *Result) error {
+	report := jsonReport{
+		Matched:    res.Matched,
+		Mismatches: []jsonMismatch{},
+		Stats:      jsonReportStats(res.Stats),
+	}
+	for _, mm := range res.Mismatches {
+		message := ""
+		if mm.Err != nil {
+			message = mm.Err.Error()
+		}
+		report.Mismatches = append(report.Mismatches, jsonMismatch{
+			Path:     formatPath(res.pathFormat, mm.Path),
+			Pointer:  FormatPathJSONPointer(mm.Path),
+			Reason:   mm.Kind,
+			Message:  message,
+			Expected: mm.Expected,
+			Actual:   mm.Actual,
+			Pattern:  mm.Pattern,
+			Matcher:  mm.Matcher,
+			Source:   mm.Source,
+		})
+	}
+	enc := json.NewEncoder(r.w)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(report)
+}
@@ -0,0 +1,51 @@
+package gomatch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONReporter(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	r, err := m.MatchResult(
+		`{"items": [{"price": "@number@"}], "a/b": 1, "name": "John"}`,
+		`{"items": [{"price": "10"}], "a/b": 2, "x~y": true}`,
+	)
+	assert.Nil(t, err)
+
+	var b strings.Builder
+	assert.Nil(t, NewJSONReporter(&b).Report(r))
+	assert.JSONEq(t, `
+	{
+		"matched": false,
+		"mismatches": [
+			{
+				"path": ".[\"a/b\"]", "pointer": "/a~1b", "reason": "value_mismatch", "message": "values are not equal",
+				"expected": 1, "actual": 2, "pattern": "", "matcher": "", "source": ""
+			},
+			{
+				"path": ".items[0].price", "pointer": "/items/0/price", "reason": "pattern_mismatch", "message": "expected number",
+				"expected": "@number@", "actual": "10", "pattern": "@number@", "matcher": "NumberMatcher", "source": ""
+			},
+			{
+				"path": ".name", "pointer": "/name", "reason": "missing_key", "message": "missing key \"name\"",
+				"expected": "John", "actual": null, "pattern": "", "matcher": "", "source": ""
+			},
+			{
+				"path": ".[\"x~y\"]", "pointer": "/x~0y", "reason": "unexpected_key", "message": "unexpected key \"x~y\"",
+				"expected": null, "actual": true, "pattern": "", "matcher": "", "source": ""
+			}
+		],
+		"stats": {"objects": 2, "arrays": 1, "values": 2, "patterns": 1}
+	}
+	`, b.String())
+	assert.Equal(t, 1, strings.Count(b.String(), "\n"))
+
+	r, err = m.MatchResult(`{"a": 1}`, `{"a": 1}`)
+	assert.Nil(t, err)
+	b.Reset()
+	assert.Nil(t, NewJSONReporter(&b).Report(r))
+	assert.JSONEq(t, `{"matched": true, "mismatches": [], "stats": {"objects": 1, "arrays": 0, "values": 1, "patterns": 0}}`, b.String())
+}
@@ -0,0 +1,91 @@
+package gomatch
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A JUnitReporter collects results as JUnit XML test cases of a single test suite.
+// Each added result is a test case, failing if the result did not match.
+// Use Flush to write the collected test cases:
+//
+//	r := gomatch.NewJUnitReporter(f, "api")
+//	r.Add("GET /users", res)
+//	r.Flush()
+type JUnitReporter struct {
+	w     io.Writer
+	suite string
+	cases []junitTestCase
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// NewJUnitReporter creates JUnitReporter writing test suite of given name to w.
+func NewJUnitReporter(w io.Writer, suite string) *JUnitReporter {
+	return &JUnitReporter{w: w, suite: suite}
+}
+
+// Add adds the result as a test case of given name.
+// Failure of the test case lists mismatches with their paths, JSON Pointers and reasons.
+func (r *JUnitReporter) Add(name string, res *Result) {
+	tc := junitTestCase{Name: name, ClassName: r.suite}
+	if !res.Matched {
+		var b strings.Builder
+		for _, mm := range res.Mismatches {
+			fmt.Fprintf(&b, "%s (%s) %s: %s\n", formatPath(res.pathFormat, mm.Path), FormatPathJSONPointer(mm.Path), mm.Kind, mm.Err)
+		}
+		problems := "mismatches"
+		if len(res.Mismatches) == 1 {
+			problems = "mismatch"
+		}
+		tc.Failure = &junitFailure{
+			Message: fmt.Sprintf("%d %s", len(res.Mismatches), problems),
+			Type:    "gomatch",
+			Text:    b.String(),
+		}
+	}
+	r.cases = append(r.cases, tc)
+}
+
+// Flush writes collected test cases as JUnit XML document and resets the reporter.
+func (r *JUnitReporter) Flush() error {
+	suite := junitTestSuite{Name: r.suite, Tests: len(r.cases), Cases: r.cases}
+	for _, tc := range r.cases {
+		if tc.Failure != nil {
+			suite.Failures++
+		}
+	}
+	b, err := xml.MarshalIndent(junitTestSuites{Suites: []junitTestSuite{suite}}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(r.w, xml.Header+string(b)+"\n"); err != nil {
+		return err
+	}
+	r.cases = nil
+	return nil
+}
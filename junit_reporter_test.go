@@ -0,0 +1,38 @@
+package gomatch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJUnitReporter(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	var b strings.Builder
+	reporter := NewJUnitReporter(&b, "api")
+
+	r, err := m.MatchResult(`{"id": "@number@"}`, `{"id": 1}`)
+	assert.Nil(t, err)
+	reporter.Add("GET /users/1", r)
+
+	r, err = m.MatchResult(`{"id": "@number@", "name": "<John>"}`, `{"id": "1", "name": "Joe"}`)
+	assert.Nil(t, err)
+	reporter.Add("GET /users/2", r)
+
+	assert.Nil(t, reporter.Flush())
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="api" tests="2" failures="1">
+    <testcase name="GET /users/1" classname="api"></testcase>
+    <testcase name="GET /users/2" classname="api">
+      <failure message="2 mismatches" type="gomatch">.id (/id) pattern_mismatch: expected number&#xA;.name (/name) value_mismatch: values are not equal&#xA;</failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`, b.String())
+
+	b.Reset()
+	assert.Nil(t, reporter.Flush())
+	assert.Contains(t, b.String(), `<testsuite name="api" tests="0" failures="0"></testsuite>`)
+}
@@ -0,0 +1,37 @@
+package gomatch
+
+import (
+	"errors"
+	"regexp"
+)
+
+var ksuidRe = regexp.MustCompile(`^[0-9A-Za-z]{27}$`)
+
+// ksuidMax is the largest KSUID, base62 alphabet is ordered the same way as ASCII.
+const ksuidMax = "aWgEPTl1tmebfsQzFP4bxwgy80V"
+
+var ErrNotKSUID = errors.New("expected KSUID")
+
+// A KSUIDMatcher matches KSUIDs, 27 characters long base62 identifiers.
+type KSUIDMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *KSUIDMatcher) CanMatch(p interface{}) bool {
+	return isPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *KSUIDMatcher) Match(p, v interface{}) (bool, error) {
+	s, ok := v.(string)
+	if !ok || !ksuidRe.MatchString(s) || s > ksuidMax {
+		return false, ErrNotKSUID
+	}
+	return true, nil
+}
+
+// NewKSUIDMatcher creates KSUIDMatcher.
+func NewKSUIDMatcher(pattern string) *KSUIDMatcher {
+	return &KSUIDMatcher{pattern}
+}
@@ -0,0 +1,66 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ksuidMatcherTests = []struct {
+	desc string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match KSUID",
+		"0ujtsYcgvSTl8PAuAdqWYSMnLOv",
+		true,
+		nil,
+	},
+	{
+		"Should match max KSUID",
+		"aWgEPTl1tmebfsQzFP4bxwgy80V",
+		true,
+		nil,
+	},
+	{
+		"Should not match KSUID above max",
+		"zzzzzzzzzzzzzzzzzzzzzzzzzzz",
+		false,
+		ErrNotKSUID,
+	},
+	{
+		"Should not match short value",
+		"0ujtsYcgvSTl8PAuAdqWYSMnLO",
+		false,
+		ErrNotKSUID,
+	},
+	{
+		"Should not match number",
+		123,
+		false,
+		ErrNotKSUID,
+	},
+}
+
+func TestKSUIDMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range ksuidMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewKSUIDMatcher(pattern)
+			assert.True(t, m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
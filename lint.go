@@ -0,0 +1,233 @@
+package gomatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrMisplacedPattern is reported for patterns used where they have no effect, e.g. as object keys.
+	ErrMisplacedPattern = errors.New("misplaced pattern")
+
+	// ErrUnvalidatedPattern is reported for patterns with arguments or constraints handled by a matcher
+	// which does not implement PatternValidator.
+	ErrUnvalidatedPattern = errors.New("pattern cannot be validated")
+)
+
+var (
+	constraintPatternRe = regexp.MustCompile(`^(@[A-Za-z_][A-Za-z0-9_]*@)\.`)
+	funcPatternRe       = regexp.MustCompile(`^@[A-Za-z_][A-Za-z0-9_]*\(`)
+	embeddedPatternRe   = regexp.MustCompile(`@[A-Za-z_][A-Za-z0-9_]*@`)
+)
+
+// A Diagnostic is a problem of a pattern found by Lint.
+type Diagnostic struct {
+	// Position locates the problematic value in the pattern, it uses file name set by Filenames.
+	Position Position
+
+	// Path is a path of the problematic value, for object keys it includes the key.
+	Path []interface{}
+
+	// Err describes the problem, e.g. ErrUnknownPattern, ErrMisplacedPattern or ErrInvalidPattern.
+	Err error
+
+	format PathFormat
+}
+
+// Error formats the diagnostic as "line:column: problem at path".
+func (d Diagnostic) Error() string {
+	return fmt.Sprintf("%s: %s at %q", d.Position, d.Err, formatPath(d.format, d.Path))
+}
+
+func (d Diagnostic) Unwrap() error {
+	return d.Err
+}
+
+// Strict enables linting of expected JSON before matching. Match, MatchParams and MatchResult then fail
+// with diagnostics of Lint instead of comparing unknown patterns as literal strings.
+// MatchParams lints expected JSON with parameters substituted.
+func (m *JSONMatcher) Strict(strict bool) {
+	m.strict = strict
+}
+
+// Lint validates pattern without matching it. It reports unknown patterns, e.g. "@strnig@",
+// invalid arguments of patterns and constraints, and patterns used where they have no effect,
+// e.g. "@...@" in the middle of an array or "@string@" embedded in a longer string.
+// Diagnostics are ordered by their paths.
+func (m *JSONMatcher) Lint(pattern string) []Diagnostic {
+	return m.lint([]byte(pattern))
+}
+
+func (m *JSONMatcher) lint(pattern []byte) []Diagnostic {
+	var expected interface{}
+	if err := json.Unmarshal(pattern, &expected); err != nil {
+		d := Diagnostic{Position: Position{File: m.expectedFile, Line: 1, Column: 1}, Path: []interface{}{}, Err: fmt.Errorf("%w: %s", errInvalidJSONPattern, err), format: m.pathFormat}
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			d.Position = scanPositions(pattern, m.expectedFile).position(max(int(syntaxErr.Offset)-1, 0))
+		}
+		return []Diagnostic{d}
+	}
+	return m.lintValue(expected, pattern)
+}
+
+// lintValue lints decoded pattern expected, diagnostics are positioned in its source.
+func (m *JSONMatcher) lintValue(expected interface{}, source []byte) []Diagnostic {
+	l := &linter{m: m, positions: scanPositions(source, m.expectedFile), diagnostics: []Diagnostic{}}
+	l.lint(expected, []interface{}{})
+	return l.diagnostics
+}
+
+type linter struct {
+	m           *JSONMatcher
+	positions   *sourcePositions
+	diagnostics []Diagnostic
+}
+
+func (l *linter) report(path []interface{}, key string, err error) {
+	d := Diagnostic{Position: l.positions.lookup(path, key), Path: path, Err: err, format: l.m.pathFormat}
+	if key != "" {
+		d.Path = appendPath(path, key)
+	}
+	l.diagnostics = append(l.diagnostics, d)
+}
+
+func (l *linter) lint(v interface{}, path []interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			switch {
+			case isUnbounded(k):
+			case isAssert(k):
+				l.assertions(v[k], path)
+			default:
+				l.key(k, path)
+				l.lint(v[k], appendPath(path, k))
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			if isUnbounded(item) && i < len(v)-1 {
+				l.report(appendPath(path, i), "", fmt.Errorf("%w %q: expected as the last array item", ErrMisplacedPattern, patternUnbounded))
+				continue
+			}
+			if !isUnbounded(item) {
+				l.lint(item, appendPath(path, i))
+			}
+		}
+	case string:
+		l.value(v, path)
+	}
+}
+
+func (l *linter) key(k string, path []interface{}) {
+	switch {
+	case l.m.handlesPattern(k):
+		l.report(path, k, fmt.Errorf("%w %q: patterns cannot be used as object keys", ErrMisplacedPattern, k))
+	case l.m.isPatternLike(k):
+		name, _ := patternToken(k)
+		l.report(path, k, l.m.errUnknownPattern(name))
+	}
+}
+
+func (l *linter) value(s string, p []interface{}) {
+	m := l.m
+	if u, ok, err := parseUnion(s); ok {
+		if err != nil {
+			l.report(p, "", err)
+			return
+		}
+		for _, name := range u.names() {
+			l.lint(u.branches[name], p)
+		}
+		return
+	}
+	if name, _, ok := parseRef(s); ok {
+		if _, ok := m.fragments[name]; !ok {
+			l.report(p, "", m.errUnknownFragment(name))
+		}
+		return
+	}
+	if name, ok, err := includeName(s); ok {
+		if err == nil {
+			_, err = fs.Stat(m.includeFiles(), path.Clean(strings.TrimPrefix(name, "/")))
+		}
+		if err != nil {
+			l.report(p, "", fmt.Errorf("%w %q: %w", ErrInclude, name, err))
+		}
+		return
+	}
+	switch {
+	case isUnbounded(s) || isAssert(s):
+		l.report(p, "", fmt.Errorf("%w %q: patterns cannot be used as values", ErrMisplacedPattern, s))
+	case m.valueMatcher.CanMatch(s):
+		if err := m.validatePattern(s); err != nil {
+			l.report(p, "", err)
+		}
+	case constraintPatternRe.MatchString(s) && m.valueMatcher.CanMatch(constraintPatternRe.FindStringSubmatch(s)[1]):
+		base := constraintPatternRe.FindStringSubmatch(s)[1]
+		calls, err := patternCalls(s, base)
+		if err == nil {
+			err = fmt.Errorf("%w in %q", errUnknownConstraint(calls[0]), s)
+		}
+		l.report(p, "", err)
+	case m.isPatternLike(s):
+		name, _ := patternToken(s)
+		l.report(p, "", m.errUnknownPattern(name))
+	default:
+		for _, token := range embeddedPatternRe.FindAllString(s, -1) {
+			if m.valueMatcher.CanMatch(token) {
+				l.report(p, "", fmt.Errorf("%w %q: patterns must be whole values", ErrMisplacedPattern, token))
+				break
+			}
+		}
+	}
+}
+
+func (l *linter) assertions(assertions interface{}, path []interface{}) {
+	sources := []interface{}{assertions}
+	if list, ok := assertions.([]interface{}); ok {
+		sources = list
+	}
+	for _, src := range sources {
+		str, ok := src.(string)
+		if !ok {
+			l.report(path, patternAssert, fmt.Errorf("%w: assertion must be a string", ErrInvalidPattern))
+			continue
+		}
+		if _, err := parseExpr(str); err != nil {
+			l.report(path, patternAssert, err)
+		}
+	}
+}
+
+// validatePattern validates arguments and constraints of pattern p handled by the value matcher.
+// Patterns without them need no validation, so matchers which do not implement PatternValidator may handle them.
+func (m *JSONMatcher) validatePattern(p string) error {
+	err := fmt.Errorf("%w %q", ErrUnvalidatedPattern, p)
+	if v, ok := m.valueMatcher.(PatternValidator); ok {
+		err = v.ValidatePattern(p)
+	}
+	if errors.Is(err, ErrUnvalidatedPattern) && !constraintPatternRe.MatchString(p) && !funcPatternRe.MatchString(p) {
+		return nil
+	}
+	return err
+}
+
+// handlesPattern returns true if p is handled by the value matcher or expanded by JSONMatcher.
+func (m *JSONMatcher) handlesPattern(p string) bool {
+	return m.valueMatcher.CanMatch(p) || isUnion(p) || isRef(p) || isInclude(p) || isUnbounded(p) || isAssert(p)
+}
+
+// isPatternLike returns true if p looks like a pattern, e.g. "@strnig@", but it is not handled.
+func (m *JSONMatcher) isPatternLike(p string) bool {
+	_, ok := patternToken(p)
+	return ok && !m.handlesPattern(p)
+}
@@ -0,0 +1,306 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONMatcherLint(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	m.IncludeFS(fstest.MapFS{"user.json": {Data: []byte(`{}`)}})
+	_ = m.RegisterFragment("user", `{"id": "@number@"}`)
+
+	valid := []struct {
+		desc string
+		p    string
+	}{
+		{"values", `{"id": "@number@", "email": "john@example.com", "tag": "@home", "items": ["@string@", "@...@"], "@...@": ""}`},
+		{"constraints", `{"ip": "@ip@.inSubnet('10.0.0.0/8')", "created": "@date@.after(\"2020-01-01T00:00:00Z\")"}`},
+		{"constraint values", `{"a": "@uuid@.version(4|7)", "b": "@geojson@.within(1, 2, 3, 4)", "c": "@phone@.country(SK)", "d": "@sha256@.digestOf('.a')"}`},
+		{"functions", `{"a": "@expr('value > 1')@", "b": "@ci('paid')@", "c": "@trim(paid)@"}`},
+		{"expanded patterns", `{"u": "@ref(user)@", "i": "@include('user.json')@", "p": "@union('type', {\"card\": {\"n\": \"@pan@\"}})@"}`},
+		{"assertions", `{"a": 1, "b": 2, "@assert@": ["a < b"]}`},
+	}
+	for _, tt := range valid {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Empty(t, m.Lint(tt.p))
+		})
+	}
+
+	tests := []struct {
+		desc    string
+		p       string
+		err     error
+		errText string
+	}{
+		{
+			"unknown pattern",
+			"{\n  \"name\": \"@strnig@\"\n}",
+			ErrUnknownPattern,
+			`2:11: unknown pattern "@strnig@": did you mean "@string@"? at ".name"`,
+		},
+		{
+			"unknown pattern without suggestion",
+			`{"name": "@johndoe@"}`,
+			ErrUnknownPattern,
+			`1:10: unknown pattern "@johndoe@" at ".name"`,
+		},
+		{
+			"pattern as key",
+			`{"@string@": 1}`,
+			ErrMisplacedPattern,
+			`misplaced pattern "@string@": patterns cannot be used as object keys at ".[\"@string@\"]"`,
+		},
+		{
+			"unknown pattern as key",
+			`{"@numbr@": 1}`,
+			ErrUnknownPattern,
+			`unknown pattern "@numbr@": did you mean "@number@"?`,
+		},
+		{
+			"unbounded in the middle of array",
+			`[1, "@...@", 2]`,
+			ErrMisplacedPattern,
+			`1:5: misplaced pattern "@...@": expected as the last array item at ".[1]"`,
+		},
+		{
+			"unbounded as value",
+			`{"a": "@...@"}`,
+			ErrMisplacedPattern,
+			`misplaced pattern "@...@": patterns cannot be used as values`,
+		},
+		{
+			"embedded pattern",
+			`{"greeting": "Hello @string@"}`,
+			ErrMisplacedPattern,
+			`misplaced pattern "@string@": patterns must be whole values at ".greeting"`,
+		},
+		{
+			"bad constraint",
+			`{"ip": "@ip@.inSubnet('10.0.0.0/8'"}`,
+			ErrInvalidPattern,
+			`invalid pattern "@ip@.inSubnet('10.0.0.0/8'"`,
+		},
+		{
+			"unterminated constraint",
+			`{"a": "@string@.foo("}`,
+			ErrInvalidPattern,
+			`invalid pattern "@string@.foo(": missing ')' at ".a"`,
+		},
+		{
+			"constraint of pattern without constraints",
+			`{"a": "@string@.foo()"}`,
+			ErrInvalidPattern,
+			`unknown constraint "foo" in "@string@.foo()"`,
+		},
+		{
+			"unknown constraint",
+			`{"a": "@uuid@.foo()"}`,
+			ErrInvalidPattern,
+			`unknown constraint "foo" in "@uuid@.foo()"`,
+		},
+		{
+			"unknown constraint after failing one",
+			`{"a": "@uuid@.canonical().foo()"}`,
+			ErrInvalidPattern,
+			`unknown constraint "foo"`,
+		},
+		{
+			"bad UUID version",
+			`{"a": "@uuid@.version(v4)"}`,
+			ErrInvalidPattern,
+			`invalid UUID version "v4"`,
+		},
+		{
+			"bad UUID version alternative",
+			`{"a": "@uuid@.version(4|v7)"}`,
+			ErrInvalidPattern,
+			`invalid UUID version "v7"`,
+		},
+		{
+			"bad length",
+			`{"a": "@nanoid@.length(abc)"}`,
+			ErrInvalidPattern,
+			`invalid length "abc"`,
+		},
+		{
+			"bad subnet",
+			`{"a": "@ip@.inSubnet('bad')"}`,
+			ErrInvalidPattern,
+			`invalid subnet "bad"`,
+		},
+		{
+			"bad version range",
+			`{"a": "@semver@.satisfies('garbage')"}`,
+			ErrInvalidPattern,
+			`invalid version range "garbage"`,
+		},
+		{
+			"bad duration range",
+			`{"a": "@duration@.between('x', 'y')"}`,
+			ErrInvalidPattern,
+			`invalid duration range`,
+		},
+		{
+			"wrong number of arguments",
+			`{"a": "@uuid@.canonical(1)"}`,
+			ErrInvalidPattern,
+			`constraint "canonical" expects 0 argument(s), got 1`,
+		},
+		{
+			"flag with arguments",
+			`{"a": "@pan@.masked(1)"}`,
+			ErrInvalidPattern,
+			`constraint "masked" expects 0 argument(s), got 1`,
+		},
+		{
+			"bad coordinate range",
+			`{"a": "@latitude@.between(1)"}`,
+			ErrInvalidPattern,
+			`constraint "between" expects 2 argument(s), got 1 in "@latitude@.between(1)"`,
+		},
+		{
+			"bad date bound",
+			`{"a": "@date@.after('yesterday')"}`,
+			ErrInvalidPattern,
+			`invalid date "yesterday"`,
+		},
+		{
+			"bad digest path",
+			`{"a": "@sha256@.digestOf('a[')"}`,
+			ErrInvalidPattern,
+			`in "@sha256@.digestOf('a[')"`,
+		},
+		{
+			"bad bounding box",
+			`{"a": "@geojson@.within(1, 2, x, 4)"}`,
+			ErrInvalidPattern,
+			`invalid bounding box`,
+		},
+		{
+			"bad phone number",
+			`{"a": "@phone@.country(SK).equals('0800')"}`,
+			ErrInvalidPattern,
+			`invalid phone number "0800"`,
+		},
+		{
+			"bad expression",
+			`{"a": "@expr('value >')@"}`,
+			ErrInvalidPattern,
+			`expression "value >"`,
+		},
+		{
+			"bad function argument",
+			`{"a": "@ci('a', 'b')@"}`,
+			ErrInvalidPattern,
+			`expected single argument`,
+		},
+		{
+			"bad union",
+			`{"a": "@union('type')@"}`,
+			ErrInvalidPattern,
+			`expected ',' after discriminator`,
+		},
+		{
+			"unknown pattern in union branch",
+			`{"a": "@union('type', {\"card\": {\"n\": \"@pn@\"}})@"}`,
+			ErrUnknownPattern,
+			`1:7: unknown pattern "@pn@": did you mean "@pan@"? at ".a.n"`,
+		},
+		{
+			"unknown fragment",
+			`{"a": "@ref(usr)@"}`,
+			ErrUnknownFragment,
+			`unknown fragment "usr": did you mean "user"?`,
+		},
+		{
+			"missing include",
+			`{"a": "@include('order.json')@"}`,
+			ErrInclude,
+			`include "order.json"`,
+		},
+		{
+			"bad assertion",
+			`{"@assert@": ["a <"]}`,
+			ErrInvalidPattern,
+			`at ".[\"@assert@\"]"`,
+		},
+		{
+			"invalid JSON",
+			"{\n  \"a\": }",
+			errInvalidJSONPattern,
+			`2:8: invalid JSON pattern`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ds := m.Lint(tt.p)
+			if assert.Len(t, ds, 1) {
+				assert.True(t, errors.Is(ds[0], tt.err), "unexpected error %v", ds[0])
+				assert.Contains(t, ds[0].Error(), tt.errText)
+			}
+		})
+	}
+}
+
+func TestJSONMatcherLintUnvalidatedPatterns(t *testing.T) {
+	m := NewJSONMatcher(NewChainMatcher([]ValueMatcher{NewStringMatcher("@string@"), constraintMatcherStub{}}))
+
+	assert.Empty(t, m.Lint(`{"name": "@string@", "price": "@money@"}`))
+
+	ds := m.Lint(`{"price": "@money@.currency(\"EUR\")"}`)
+	if assert.Len(t, ds, 1) {
+		assert.True(t, errors.Is(ds[0], ErrUnvalidatedPattern))
+		assert.Equal(t, `1:11: pattern cannot be validated "@money@.currency(\"EUR\")" at ".price"`, ds[0].Error())
+	}
+
+	m = NewJSONMatcher(constraintMatcherStub{})
+	ds = m.Lint(`{"price": "@money@.currency(\"EUR\")"}`)
+	if assert.Len(t, ds, 1) {
+		assert.True(t, errors.Is(ds[0], ErrUnvalidatedPattern))
+	}
+}
+
+func TestJSONMatcherStrict(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	p := `{"name": "@strnig@", "items": ["@...@", 1]}`
+	v := `{"name": "@strnig@", "items": [1]}`
+
+	ok, err := m.Match(p, v)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+
+	m.Strict(true)
+	m.Filenames("expected.json", "")
+	ok, err = m.Match(p, v)
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrMisplacedPattern))
+	assert.True(t, errors.Is(err, ErrUnknownPattern))
+	assert.Contains(t, err.Error(), `expected.json:1:10: unknown pattern "@strnig@"`)
+
+	_, err = m.MatchResult(p, v)
+	assert.True(t, errors.Is(err, ErrUnknownPattern))
+}
+
+func TestJSONMatcherStrictWithParams(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	m.Strict(true)
+	p := `{"a": "@expr(value > ${limit})@", "b": "${pattern}"}`
+
+	ok, err := m.MatchParams(p, `{"a": 10, "b": "x"}`, ParamsMap(map[string]interface{}{"limit": 5, "pattern": "@string@"}))
+	assert.True(t, ok)
+	assert.Nil(t, err)
+
+	ok, err = m.MatchParams(p, `{"a": 10, "b": "x"}`, ParamsMap(map[string]interface{}{"limit": 5, "pattern": "@strnig@"}))
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrUnknownPattern))
+	assert.Contains(t, err.Error(), `1:40: unknown pattern "@strnig@"`)
+
+	ok, err = m.MatchParams(p, `{"a": 10, "b": "x"}`, ParamsMap(map[string]interface{}{"pattern": "@string@"}))
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrUnresolvedParam))
+}
@@ -0,0 +1,39 @@
+package gomatch
+
+import (
+	"errors"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+var ErrNotLocale = errors.New("expected BCP 47 language tag")
+
+// A LocaleMatcher matches well-formed BCP 47 language tags with known subtags, e.g. "en-US" or "zh-Hant-TW".
+type LocaleMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *LocaleMatcher) CanMatch(p interface{}) bool {
+	return isPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *LocaleMatcher) Match(p, v interface{}) (bool, error) {
+	s, ok := v.(string)
+	// language.Parse also accepts POSIX style "en_US" which is not a BCP 47 tag.
+	if !ok || strings.Contains(s, "_") {
+		return false, ErrNotLocale
+	}
+	_, err := language.Parse(s)
+	if err != nil {
+		return false, ErrNotLocale
+	}
+	return true, nil
+}
+
+// NewLocaleMatcher creates LocaleMatcher.
+func NewLocaleMatcher(pattern string) *LocaleMatcher {
+	return &LocaleMatcher{pattern}
+}
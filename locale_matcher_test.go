@@ -0,0 +1,78 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var localeMatcherTests = []struct {
+	desc string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match language",
+		"sk",
+		true,
+		nil,
+	},
+	{
+		"Should match language and region",
+		"en-US",
+		true,
+		nil,
+	},
+	{
+		"Should match language with script and region",
+		"zh-Hant-TW",
+		true,
+		nil,
+	},
+	{
+		"Should not match POSIX locale",
+		"en_US",
+		false,
+		ErrNotLocale,
+	},
+	{
+		"Should not match unknown language",
+		"xx",
+		false,
+		ErrNotLocale,
+	},
+	{
+		"Should not match ill-formed tag",
+		"en-",
+		false,
+		ErrNotLocale,
+	},
+	{
+		"Should not match number",
+		1,
+		false,
+		ErrNotLocale,
+	},
+}
+
+func TestLocaleMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range localeMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewLocaleMatcher(pattern)
+			assert.True(t, m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
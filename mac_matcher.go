@@ -0,0 +1,36 @@
+package gomatch
+
+import (
+	"errors"
+	"net"
+)
+
+var ErrNotMAC = errors.New("expected MAC address")
+
+// A MACMatcher matches MAC addresses in any format accepted by net.ParseMAC.
+type MACMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *MACMatcher) CanMatch(p interface{}) bool {
+	return isPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *MACMatcher) Match(p, v interface{}) (bool, error) {
+	s, ok := v.(string)
+	if !ok {
+		return false, ErrNotMAC
+	}
+	_, err := net.ParseMAC(s)
+	if err != nil {
+		return false, ErrNotMAC
+	}
+	return true, nil
+}
+
+// NewMACMatcher creates MACMatcher.
+func NewMACMatcher(pattern string) *MACMatcher {
+	return &MACMatcher{pattern}
+}
@@ -0,0 +1,60 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var macMatcherTests = []struct {
+	desc string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match colon separated MAC",
+		"00:1a:2b:3c:4d:5e",
+		true,
+		nil,
+	},
+	{
+		"Should match dash separated MAC",
+		"00-1A-2B-3C-4D-5E",
+		true,
+		nil,
+	},
+	{
+		"Should not match invalid MAC",
+		"00:1a:2b:3c:4d",
+		false,
+		ErrNotMAC,
+	},
+	{
+		"Should not match number",
+		123,
+		false,
+		ErrNotMAC,
+	},
+}
+
+func TestMACMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range macMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewMACMatcher(pattern)
+			assert.True(t, m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package gomatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrRead is returned by MatchReader when reading of expected or actual JSON fails.
+var ErrRead = errors.New("read failed")
+
+// Marshaler sets function used by MatchValue to encode Go values to JSON, json.Marshal is used by default.
+func (m *JSONMatcher) Marshaler(f JSONMarshalFn) {
+	m.marshaler = f
+}
+
+// MatchBytes performs deep match like Match, but JSONs are given as byte slices.
+func (m *JSONMatcher) MatchBytes(expectedJSON, actualJSON []byte) (bool, error) {
+	return m.matchBytes(expectedJSON, actualJSON, nil)
+}
+
+// MatchReader performs deep match like Match, but JSONs are read from readers, e.g. a file and an HTTP response body.
+// Errors of the readers are reported as ErrRead errors wrapping them.
+func (m *JSONMatcher) MatchReader(expected, actual io.Reader) (bool, error) {
+	expectedJSON, err := io.ReadAll(expected)
+	if err != nil {
+		return false, fmt.Errorf("%w: expected JSON: %w", ErrRead, err)
+	}
+	actualJSON, err := io.ReadAll(actual)
+	if err != nil {
+		return false, fmt.Errorf("%w: actual JSON: %w", ErrRead, err)
+	}
+	return m.matchBytes(expectedJSON, actualJSON, nil)
+}
+
+// MatchValue performs deep match like Match, but expected and actual values are Go values encoded
+// to JSON by the function set with Marshaler, e.g. a struct and a pattern built as a map:
+//
+//	m.MatchValue(map[string]any{"id": "@uuid@", "tags": []any{"@string@", "@...@"}}, user)
+func (m *JSONMatcher) MatchValue(expected, actual any) (bool, error) {
+	marshal := m.marshaler
+	if marshal == nil {
+		marshal = json.Marshal
+	}
+	expectedJSON, err := marshal(expected)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", errInvalidJSONPattern, err)
+	}
+	actualJSON, err := marshal(actual)
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", errInvalidJSON, err)
+	}
+	return m.matchBytes(expectedJSON, actualJSON, nil)
+}
+
+func (m *JSONMatcher) matchBytes(expectedJSON, actualJSON []byte, params ParamResolver) (bool, error) {
+	s := &matchState{}
+	expected, actual, err := m.decode(s, expectedJSON, actualJSON, params)
+	if err != nil {
+		return false, err
+	}
+	err = m.matchDecoded(s, expected, actual, params)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
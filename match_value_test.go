@@ -0,0 +1,89 @@
+package gomatch
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONMatcherMatchBytes(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+
+	ok, err := m.MatchBytes([]byte(`{"id": "@number@"}`), []byte(`{"id": 1}`))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.MatchBytes([]byte(`{"id": "@number@"}`), []byte(`{"id": "1"}`))
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, errNotNumber))
+
+	_, err = m.MatchBytes([]byte(`{`), []byte(`{}`))
+	assert.True(t, errors.Is(err, errInvalidJSONPattern))
+	_, err = m.MatchBytes([]byte(`{}`), nil)
+	assert.True(t, errors.Is(err, errInvalidJSON))
+}
+
+func TestJSONMatcherMatchReader(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+
+	ok, err := m.MatchReader(strings.NewReader(`{"id": "@number@"}`), strings.NewReader(`{"id": 1}`))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.MatchReader(strings.NewReader(`{"id": 2}`), strings.NewReader(`{"id": 1}`))
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, errValuesNotEqual))
+
+	readErr := errors.New("connection reset")
+	_, err = m.MatchReader(strings.NewReader(`{}`), iotest.ErrReader(readErr))
+	assert.EqualError(t, err, "read failed: actual JSON: connection reset")
+	assert.True(t, errors.Is(err, ErrRead))
+	assert.True(t, errors.Is(err, readErr))
+	assert.False(t, errors.Is(err, errInvalidJSON))
+	_, err = m.MatchReader(iotest.ErrReader(readErr), strings.NewReader(`{}`))
+	assert.True(t, errors.Is(err, ErrRead))
+	assert.False(t, errors.Is(err, errInvalidJSONPattern))
+
+	_, err = m.MatchReader(strings.NewReader(`{}`), strings.NewReader(`{`))
+	assert.True(t, errors.Is(err, errInvalidJSON))
+	assert.False(t, errors.Is(err, ErrRead))
+}
+
+func TestJSONMatcherMatchValue(t *testing.T) {
+	type user struct {
+		ID    int      `json:"id"`
+		Name  string   `json:"name"`
+		Tags  []string `json:"tags"`
+		Notes string   `json:"notes,omitempty"`
+	}
+	m := NewDefaultJSONMatcher()
+	pattern := map[string]any{"id": "@number@", "name": "@string@", "tags": []any{"@string@", "@...@"}}
+
+	ok, err := m.MatchValue(pattern, user{ID: 1, Name: "John", Tags: []string{"a", "b"}})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = m.MatchValue(pattern, user{ID: 1, Name: "John", Notes: "<b>"})
+	assert.False(t, ok)
+	assert.True(t, errors.Is(err, ErrUnexpectedKey))
+	assert.True(t, errors.Is(err, ErrTypesNotEqual))
+
+	_, err = m.MatchValue(pattern, func() {})
+	assert.True(t, errors.Is(err, errInvalidJSON))
+	_, err = m.MatchValue(make(chan int), user{})
+	assert.True(t, errors.Is(err, errInvalidJSONPattern))
+
+	calls := 0
+	m.Marshaler(func(v any) ([]byte, error) {
+		calls++
+		return json.MarshalIndent(v, "", "  ")
+	})
+	ok, err = m.MatchValue(json.RawMessage(`{"a": "@bool@"}`), map[string]bool{"a": true})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 2, calls)
+}
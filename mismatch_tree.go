@@ -0,0 +1,121 @@
+package gomatch
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// A MismatchTree groups mismatches by their paths into a tree mirroring the actual JSON.
+// Only nodes containing mismatches are present in the tree.
+type MismatchTree struct {
+	// Key is an object key or an array index of the node in its parent, nil for the root.
+	Key interface{}
+
+	// Path is a path of the node in the actual JSON.
+	Path []interface{}
+
+	// Mismatches are located directly at the node.
+	Mismatches []Mismatch
+
+	// Children are sorted by array indexes and object keys.
+	Children []*MismatchTree
+
+	format PathFormat
+}
+
+// Tree returns mismatches of the result grouped by their paths.
+func (r *Result) Tree() *MismatchTree {
+	root := &MismatchTree{Path: []interface{}{}, format: r.pathFormat}
+	for _, mm := range r.Mismatches {
+		node := root
+		for _, key := range mm.Path {
+			node = node.child(key)
+		}
+		node.Mismatches = append(node.Mismatches, mm)
+	}
+	return root
+}
+
+// Count returns number of mismatches located at the node and under it.
+func (t *MismatchTree) Count() int {
+	n := len(t.Mismatches)
+	for _, c := range t.Children {
+		n += c.Count()
+	}
+	return n
+}
+
+// Find returns node at given path or nil if there are no mismatches at or under the path.
+func (t *MismatchTree) Find(path []interface{}) *MismatchTree {
+	node := t
+	for _, key := range path {
+		i, found := node.search(key)
+		if !found {
+			return nil
+		}
+		node = node.Children[i]
+	}
+	return node
+}
+
+// String renders the tree with counts of mismatches, e.g.
+//
+//	. (3 problems)
+//	  .items[2] (2 problems)
+//	    - missing key "price"
+//	    - unexpected key "cost"
+//	  .total (1 problem)
+//	    - values are not equal
+//
+// Paths are formatted by the format set with FormatPaths.
+func (t *MismatchTree) String() string {
+	var b strings.Builder
+	t.render(&b, "")
+	return b.String()
+}
+
+func (t *MismatchTree) render(b *strings.Builder, indent string) {
+	problems := "problems"
+	if n := t.Count(); n == 1 {
+		problems = "problem"
+	}
+	fmt.Fprintf(b, "%s%s (%d %s)\n", indent, formatPath(t.format, t.Path), t.Count(), problems)
+	for _, mm := range t.Mismatches {
+		fmt.Fprintf(b, "%s  - %s\n", indent, mm.Err)
+	}
+	for _, c := range t.Children {
+		c.render(b, indent+"  ")
+	}
+}
+
+// child returns child node with given key, it is created if it does not exist.
+func (t *MismatchTree) child(key interface{}) *MismatchTree {
+	i, found := t.search(key)
+	if !found {
+		c := &MismatchTree{Key: key, Path: appendPath(t.Path, key), format: t.format}
+		t.Children = slices.Insert(t.Children, i, c)
+	}
+	return t.Children[i]
+}
+
+func (t *MismatchTree) search(key interface{}) (int, bool) {
+	return slices.BinarySearchFunc(t.Children, key, func(c *MismatchTree, key interface{}) int {
+		return compareKeys(c.Key, key)
+	})
+}
+
+// compareKeys orders array indexes before object keys.
+func compareKeys(a, b interface{}) int {
+	ai, aIsIndex := a.(int)
+	bi, bIsIndex := b.(int)
+	switch {
+	case aIsIndex && bIsIndex:
+		return ai - bi
+	case aIsIndex:
+		return -1
+	case bIsIndex:
+		return 1
+	}
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
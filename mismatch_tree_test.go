@@ -0,0 +1,36 @@
+package gomatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMismatchTree(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	r, err := m.MatchResult(
+		`{"total": 10, "items": [{"price": 1}, {"price": 2}, {"price": "@number@", "name": "@string@"}], "id": "@number@"}`,
+		`{"total": 11, "items": [{"price": 1}, {"price": 2}, {"cost": 3, "name": 1}], "id": 1}`,
+	)
+	assert.Nil(t, err)
+
+	tree := r.Tree()
+	assert.Equal(t, 4, tree.Count())
+	assert.Equal(t, 3, tree.Find([]interface{}{"items", 2}).Count())
+	assert.Equal(t, 1, tree.Find([]interface{}{"items", 2, "name"}).Count())
+	assert.Nil(t, tree.Find([]interface{}{"items", 0}))
+	assert.Nil(t, tree.Find([]interface{}{"id"}))
+
+	assert.Equal(t, `. (4 problems)
+  .items (3 problems)
+    .items[2] (3 problems)
+      .items[2].cost (1 problem)
+        - unexpected key "cost"
+      .items[2].name (1 problem)
+        - expected string
+      .items[2].price (1 problem)
+        - expected number
+  .total (1 problem)
+    - values are not equal
+`, tree.String())
+}
@@ -0,0 +1,80 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+var nanoIDRe = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+const nanoIDDefaultLength = 21
+
+var ErrNotNanoID = errors.New("expected Nano ID")
+
+// A NanoIDMatcher matches Nano IDs using the default URL friendly alphabet.
+//
+// By default it expects 21 characters, other lengths may be given by a constraint:
+//
+//	"@nanoid@.length(10)"
+type NanoIDMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *NanoIDMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *NanoIDMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	length := nanoIDDefaultLength
+	for _, c := range calls {
+		switch c.name {
+		case "length":
+			length, err = parseNanoIDLength(c)
+			if err != nil {
+				return false, err
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	s, ok := v.(string)
+	if !ok || len(s) != length || !nanoIDRe.MatchString(s) {
+		return false, ErrNotNanoID
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *NanoIDMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		if c.name != "length" {
+			return errUnknownConstraint(c)
+		}
+		_, err := parseNanoIDLength(c)
+		return err
+	})
+}
+
+func parseNanoIDLength(c patternCall) (int, error) {
+	if len(c.args) != 1 {
+		return 0, errConstraintArgs(c, 1)
+	}
+	length, err := strconv.Atoi(c.args[0])
+	if err != nil || length <= 0 {
+		return 0, fmt.Errorf("%w: invalid length %q", ErrInvalidPattern, c.args[0])
+	}
+	return length, nil
+}
+
+// NewNanoIDMatcher creates NanoIDMatcher.
+func NewNanoIDMatcher(pattern string) *NanoIDMatcher {
+	return &NanoIDMatcher{pattern}
+}
@@ -0,0 +1,77 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var nanoIDMatcherTests = []struct {
+	desc string
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match Nano ID",
+		"@pattern@",
+		"V1StGXR8_Z5jdHi6B-myT",
+		true,
+		nil,
+	},
+	{
+		"Should not match Nano ID of other length",
+		"@pattern@",
+		"V1StGXR8_Z",
+		false,
+		ErrNotNanoID,
+	},
+	{
+		"Should match Nano ID of given length",
+		"@pattern@.length(10)",
+		"V1StGXR8_Z",
+		true,
+		nil,
+	},
+	{
+		"Should not match invalid character",
+		"@pattern@",
+		"V1StGXR8_Z5jdHi6B+myT",
+		false,
+		ErrNotNanoID,
+	},
+	{
+		"Should fail on invalid length",
+		"@pattern@.length(x)",
+		"V1StGXR8_Z",
+		false,
+		ErrInvalidPattern,
+	},
+	{
+		"Should not match number",
+		"@pattern@",
+		123,
+		false,
+		ErrNotNanoID,
+	},
+}
+
+func TestNanoIDMatcher(t *testing.T) {
+	for _, tt := range nanoIDMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewNanoIDMatcher("@pattern@")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
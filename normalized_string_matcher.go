@@ -0,0 +1,54 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrStringNotEqual = errors.New("expected string equal to")
+
+// A NormalizedStringMatcher matches strings equal to the pattern argument in a given StringMode,
+// e.g. "@ci('paid')@" matches "PAID".
+type NormalizedStringMatcher struct {
+	name string
+	mode StringMode
+	desc string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *NormalizedStringMatcher) CanMatch(p interface{}) bool {
+	_, ok := patternFunc(p, m.name)
+	return ok
+}
+
+// Match performs value matching against given pattern.
+func (m *NormalizedStringMatcher) Match(p, v interface{}) (bool, error) {
+	expected, err := patternFuncArg(p, m.name)
+	if err != nil {
+		return false, err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return false, ErrNotString
+	}
+	if !m.mode.equal(expected, s) {
+		return false, fmt.Errorf("%w %q %s", ErrStringNotEqual, expected, m.desc)
+	}
+	return true, nil
+}
+
+// ValidatePattern validates argument of pattern p.
+func (m *NormalizedStringMatcher) ValidatePattern(p string) error {
+	_, err := patternFuncArg(p, m.name)
+	return err
+}
+
+// NewCIMatcher creates NormalizedStringMatcher comparing strings case-insensitively, e.g. "@ci('paid')@".
+func NewCIMatcher(name string) *NormalizedStringMatcher {
+	return &NormalizedStringMatcher{name, IgnoreCase | NormalizeUnicode, "ignoring case"}
+}
+
+// NewTrimMatcher creates NormalizedStringMatcher ignoring leading, trailing and repeated whitespace, e.g. "@trim('x')@".
+func NewTrimMatcher(name string) *NormalizedStringMatcher {
+	return &NormalizedStringMatcher{name, IgnoreWhitespace | NormalizeUnicode, "ignoring whitespace"}
+}
@@ -0,0 +1,107 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var normalizedStringMatcherTests = []struct {
+	desc string
+	m    *NormalizedStringMatcher
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match string in other case",
+		NewCIMatcher("ci"),
+		"@ci('paid')@",
+		"PAID",
+		true,
+		nil,
+	},
+	{
+		"Should match string with folded case",
+		NewCIMatcher("ci"),
+		`@ci("straße")@`,
+		"STRASSE",
+		true,
+		nil,
+	},
+	{
+		"Should not match other string ignoring case",
+		NewCIMatcher("ci"),
+		"@ci(paid)@",
+		"unpaid",
+		false,
+		ErrStringNotEqual,
+	},
+	{
+		"Should not match padded string ignoring case",
+		NewCIMatcher("ci"),
+		"@ci(paid)@",
+		" paid ",
+		false,
+		ErrStringNotEqual,
+	},
+	{
+		"Should match padded string",
+		NewTrimMatcher("trim"),
+		"@trim('John Smith')@",
+		"  John \t Smith\n",
+		true,
+		nil,
+	},
+	{
+		"Should match string in other normalization form",
+		NewTrimMatcher("trim"),
+		"@trim('caf\u00e9')@",
+		"cafe\u0301",
+		true,
+		nil,
+	},
+	{
+		"Should not match string in other case ignoring whitespace",
+		NewTrimMatcher("trim"),
+		"@trim('John Smith')@",
+		"john smith",
+		false,
+		ErrStringNotEqual,
+	},
+	{
+		"Should not match number",
+		NewCIMatcher("ci"),
+		"@ci('1')@",
+		1,
+		false,
+		ErrNotString,
+	},
+	{
+		"Should fail on multiple arguments",
+		NewTrimMatcher("trim"),
+		"@trim('a', 'b')@",
+		"a",
+		false,
+		ErrInvalidPattern,
+	},
+}
+
+func TestNormalizedStringMatcher(t *testing.T) {
+	for _, tt := range normalizedStringMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.True(t, tt.m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := tt.m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package gomatch
+
+import (
+	"errors"
+	"regexp"
+)
+
+var objectIDRe = regexp.MustCompile(`^[0-9a-fA-F]{24}$`)
+
+var ErrNotObjectID = errors.New("expected ObjectID")
+
+// An ObjectIDMatcher matches MongoDB ObjectIDs, 24 characters long hex strings.
+type ObjectIDMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *ObjectIDMatcher) CanMatch(p interface{}) bool {
+	return isPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *ObjectIDMatcher) Match(p, v interface{}) (bool, error) {
+	s, ok := v.(string)
+	if !ok || !objectIDRe.MatchString(s) {
+		return false, ErrNotObjectID
+	}
+	return true, nil
+}
+
+// NewObjectIDMatcher creates ObjectIDMatcher.
+func NewObjectIDMatcher(pattern string) *ObjectIDMatcher {
+	return &ObjectIDMatcher{pattern}
+}
@@ -0,0 +1,60 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var objectIDMatcherTests = []struct {
+	desc string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match ObjectID",
+		"507f1f77bcf86cd799439011",
+		true,
+		nil,
+	},
+	{
+		"Should not match short value",
+		"507f1f77bcf86cd79943901",
+		false,
+		ErrNotObjectID,
+	},
+	{
+		"Should not match non hex value",
+		"507f1f77bcf86cd79943901z",
+		false,
+		ErrNotObjectID,
+	},
+	{
+		"Should not match number",
+		123,
+		false,
+		ErrNotObjectID,
+	},
+}
+
+func TestObjectIDMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range objectIDMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewObjectIDMatcher(pattern)
+			assert.True(t, m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package gomatch
+
+import (
+	"errors"
+	"regexp"
+)
+
+var (
+	panRe       = regexp.MustCompile(`^[0-9]{12,19}$`)
+	maskedPANRe = regexp.MustCompile(`^[0-9]{0,8}[*xX•]+[0-9]{0,4}$`)
+)
+
+var ErrNotPAN = errors.New("expected card number")
+
+// A PANMatcher matches payment card numbers (PAN) passing the Luhn check.
+//
+// Masked card numbers, revealing at most first 8 and last 4 digits, are expected when a constraint is used:
+//
+//	"@pan@.masked()"
+type PANMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *PANMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *PANMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	masked := false
+	for _, c := range calls {
+		switch c.name {
+		case "masked":
+			if len(c.args) != 0 {
+				return false, errConstraintArgs(c, 0)
+			}
+			masked = true
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	s, ok := v.(string)
+	if !ok {
+		return false, ErrNotPAN
+	}
+	if masked {
+		n := len([]rune(s))
+		if n < 12 || n > 19 || !maskedPANRe.MatchString(s) {
+			return false, ErrNotPAN
+		}
+		return true, nil
+	}
+	if !panRe.MatchString(s) || !luhnValid(s) {
+		return false, ErrNotPAN
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *PANMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		return validateFlagCall(c, "masked")
+	})
+}
+
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// NewPANMatcher creates PANMatcher.
+func NewPANMatcher(pattern string) *PANMatcher {
+	return &PANMatcher{pattern}
+}
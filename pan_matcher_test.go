@@ -0,0 +1,105 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var panMatcherTests = []struct {
+	desc string
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match card number",
+		"@pattern@",
+		"4111111111111111",
+		true,
+		nil,
+	},
+	{
+		"Should not match card number failing Luhn check",
+		"@pattern@",
+		"4111111111111112",
+		false,
+		ErrNotPAN,
+	},
+	{
+		"Should not match short number",
+		"@pattern@",
+		"42",
+		false,
+		ErrNotPAN,
+	},
+	{
+		"Should not match masked card number by default",
+		"@pattern@",
+		"411111******1111",
+		false,
+		ErrNotPAN,
+	},
+	{
+		"Should match masked card number",
+		"@pattern@.masked()",
+		"411111******1111",
+		true,
+		nil,
+	},
+	{
+		"Should match masked card number with bullets",
+		"@pattern@.masked()",
+		"••••••••••••1111",
+		true,
+		nil,
+	},
+	{
+		"Should not match card number revealing too many digits",
+		"@pattern@.masked()",
+		"411111111***1111",
+		false,
+		ErrNotPAN,
+	},
+	{
+		"Should not match unmasked card number when masked expected",
+		"@pattern@.masked()",
+		"4111111111111111",
+		false,
+		ErrNotPAN,
+	},
+	{
+		"Should not match number",
+		"@pattern@",
+		4111111111111111.,
+		false,
+		ErrNotPAN,
+	},
+	{
+		"Should fail on masked constraint with arguments",
+		"@pattern@.masked('*')",
+		"411111******1111",
+		false,
+		ErrInvalidPattern,
+	},
+}
+
+func TestPANMatcher(t *testing.T) {
+	for _, tt := range panMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewPANMatcher("@pattern@")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package gomatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+var ErrUnresolvedParam = errors.New("unresolved parameter")
+
+// A ParamResolver returns value of parameter name used in expected JSON as "${name}".
+type ParamResolver func(name string) (interface{}, bool)
+
+// ParamsMap creates ParamResolver resolving parameters from map params.
+func ParamsMap(params map[string]interface{}) ParamResolver {
+	return func(name string) (interface{}, bool) {
+		v, ok := params[name]
+		return v, ok
+	}
+}
+
+// MatchParams performs deep match like Match, but it substitutes "${name}" placeholders
+// in expected JSON with parameters resolved by params first.
+//
+// A string consisting of a single placeholder is replaced with the parameter value keeping its type,
+// so "${userId}" becomes a number if the parameter is a number. Placeholders inside of longer strings
+// and object keys are replaced with the parameter formatted as a string, e.g. "@expr(value > ${limit})@".
+// "$${" may be used to write "${" literally.
+//
+// Placeholders are substituted in fragments and included files as well.
+// Values are converted to JSON types using encoding/json, e.g. Go structs become objects.
+// Unresolved parameters are reported as ErrUnresolvedParam errors.
+// Placeholders are kept as they are when params is nil.
+func (m *JSONMatcher) MatchParams(expectedJSON, actualJSON string, params ParamResolver) (bool, error) {
+	return m.matchBytes([]byte(expectedJSON), []byte(actualJSON), params)
+}
+
+// substituteParams substitutes parameters of the match in expected value located at path.
+// Values are returned unchanged when the match has no parameters.
+func (s *matchState) substituteParams(expected interface{}, path []interface{}) (interface{}, error) {
+	if s.params == nil {
+		return expected, nil
+	}
+	return substituteParams(expected, s.params, path)
+}
+
+// substituteParams returns copy of expected value with placeholders replaced.
+func substituteParams(expected interface{}, params ParamResolver, path []interface{}) (interface{}, error) {
+	switch e := expected.(type) {
+	case []interface{}:
+		result := make([]interface{}, len(e))
+		errs := []error{}
+		for i, v := range e {
+			r, err := substituteParams(v, params, appendPath(path, i))
+			result[i] = r
+			errs = append(errs, err)
+		}
+		return result, errors.Join(errs...)
+
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(e))
+		errs := []error{}
+		for _, k := range slices.Sorted(maps.Keys(e)) {
+			v := e[k]
+			key, err := interpolateParams(k, params)
+			if err != nil {
+				errs = append(errs, NewErrGomatch(err, path, k, nil, k))
+				continue
+			}
+			r, err := substituteParams(v, params, appendPath(path, key))
+			result[key] = r
+			errs = append(errs, err)
+		}
+		return result, errors.Join(errs...)
+
+	case string:
+		if name, ok := paramName(e); ok {
+			v, ok := params(name)
+			if !ok {
+				return e, NewErrGomatch(fmt.Errorf("%w %q", ErrUnresolvedParam, name), path, e, nil, "")
+			}
+			v, err := jsonValue(v)
+			if err != nil {
+				return e, NewErrGomatch(fmt.Errorf("%w %q: %w", ErrInvalidPattern, name, err), path, e, nil, "")
+			}
+			return v, nil
+		}
+		s, err := interpolateParams(e, params)
+		if err != nil {
+			return e, NewErrGomatch(err, path, e, nil, "")
+		}
+		return s, nil
+	}
+	return expected, nil
+}
+
+// paramName returns name of the parameter if s consists of a single placeholder.
+func paramName(s string) (string, bool) {
+	if !strings.HasPrefix(s, "${") || !strings.HasSuffix(s, "}") || strings.Count(s, "}") != 1 {
+		return "", false
+	}
+	return s[2 : len(s)-1], true
+}
+
+// interpolateParams replaces placeholders in s with parameters formatted as strings.
+func interpolateParams(s string, params ParamResolver) (string, error) {
+	if !strings.Contains(s, "${") {
+		return s, nil
+	}
+	var b strings.Builder
+	errs := []error{}
+	for {
+		i := strings.Index(s, "${")
+		if i < 0 {
+			b.WriteString(s)
+			break
+		}
+		if i > 0 && s[i-1] == '$' {
+			b.WriteString(s[:i-1] + "${")
+			s = s[i+2:]
+			continue
+		}
+		end := strings.IndexByte(s[i:], '}')
+		if end < 0 {
+			b.WriteString(s)
+			break
+		}
+		b.WriteString(s[:i])
+		name := s[i+2 : i+end]
+		v, ok := params(name)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w %q", ErrUnresolvedParam, name))
+		} else if str, ok := v.(string); ok {
+			b.WriteString(str)
+		} else {
+			b.WriteString(valueOf(v))
+		}
+		s = s[i+end+1:]
+	}
+	return b.String(), errors.Join(errs...)
+}
+
+// jsonValue converts v to a value of the same type as decoded by encoding/json.
+func jsonValue(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var result interface{}
+	err = json.Unmarshal(b, &result)
+	return result, err
+}
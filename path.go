@@ -0,0 +1,106 @@
+package gomatch
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parsePath parses a yq-like path, e.g. ".items[0].price", as produced by pathToString.
+// Keys with special characters may be quoted: `.headers["content-type"]` or `."content-type"`.
+func parsePath(s string) ([]interface{}, error) {
+	if !strings.HasPrefix(s, ".") {
+		return nil, fmt.Errorf("path %q must start with '.'", s)
+	}
+	path := []interface{}{}
+	rest := s[1:]
+	for rest != "" {
+		switch {
+		case rest[0] == '[' && len(rest) > 1 && (rest[1] == '"' || rest[1] == '\''):
+			key, n, err := parseQuotedKey(rest[1:])
+			if err != nil || n+1 >= len(rest) || rest[n+1] != ']' {
+				return nil, fmt.Errorf("invalid path %q", s)
+			}
+			path = append(path, key)
+			rest = rest[n+2:]
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q", s)
+			}
+			i, err := strconv.Atoi(rest[1:end])
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid index in path %q", s)
+			}
+			path = append(path, i)
+			rest = rest[end+1:]
+		case rest[0] == '"' || rest[0] == '\'':
+			key, n, err := parseQuotedKey(rest)
+			if err != nil {
+				return nil, fmt.Errorf("invalid path %q", s)
+			}
+			path = append(path, key)
+			rest = rest[n:]
+		default:
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid path %q", s)
+			}
+			path = append(path, rest[:end])
+			rest = rest[end:]
+		}
+		if strings.HasPrefix(rest, ".") {
+			rest = rest[1:]
+			if rest == "" {
+				return nil, fmt.Errorf("invalid path %q", s)
+			}
+		}
+	}
+	return path, nil
+}
+
+// parseQuotedKey parses a quoted key and returns the key and the number of consumed bytes.
+func parseQuotedKey(s string) (string, int, error) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			}
+		case s[0]:
+			return b.String(), i + 1, nil
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated key %q", s)
+}
+
+// lookupPath returns value located by path in given JSON value.
+func lookupPath(v interface{}, path []interface{}) (interface{}, bool) {
+	for _, p := range path {
+		switch k := p.(type) {
+		case int:
+			arr, ok := v.([]interface{})
+			if !ok || k >= len(arr) {
+				return nil, false
+			}
+			v = arr[k]
+		case string:
+			obj, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			v, ok = obj[k]
+			if !ok {
+				return nil, false
+			}
+		}
+	}
+	return v, true
+}
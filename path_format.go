@@ -0,0 +1,97 @@
+package gomatch
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var plainKeyRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// A PathFormat formats a path of a value in JSON. Path consists of object keys (string)
+// and array indexes (int).
+type PathFormat func(path []interface{}) string
+
+// FormatPaths sets format of paths in error messages, results, reports, lint diagnostics
+// and the path identifier of expressions, e.g. FormatPathJSONPointer. FormatPathYQ is used by default.
+func (m *JSONMatcher) FormatPaths(f PathFormat) {
+	m.pathFormat = f
+}
+
+// FormatPathYQ formats path in yq syntax, e.g. `.items[0].price` or `.headers["content-type"]`.
+func FormatPathYQ(path []interface{}) string {
+	var b strings.Builder
+	b.WriteByte('.')
+	for _, p := range path {
+		switch v := p.(type) {
+		case int:
+			b.WriteString("[" + strconv.Itoa(v) + "]")
+		case string:
+			if !plainKeyRe.MatchString(v) {
+				b.WriteString(`["` + escapeKey(v, '"') + `"]`)
+				continue
+			}
+			if b.Len() > 1 {
+				b.WriteByte('.')
+			}
+			b.WriteString(v)
+		}
+	}
+	return b.String()
+}
+
+// FormatPathJSONPath formats path as JSONPath, e.g. `$.items[0]['content-type']`.
+func FormatPathJSONPath(path []interface{}) string {
+	var b strings.Builder
+	b.WriteByte('$')
+	for _, p := range path {
+		switch v := p.(type) {
+		case int:
+			b.WriteString("[" + strconv.Itoa(v) + "]")
+		case string:
+			if plainKeyRe.MatchString(v) {
+				b.WriteString("." + v)
+			} else {
+				b.WriteString("['" + escapeKey(v, '\'') + "']")
+			}
+		}
+	}
+	return b.String()
+}
+
+// FormatPathJSONPointer formats path as RFC 6901 JSON Pointer, e.g. `/items/0/content-type`.
+// The root is formatted as an empty string.
+func FormatPathJSONPointer(path []interface{}) string {
+	var b strings.Builder
+	for _, p := range path {
+		b.WriteByte('/')
+		switch v := p.(type) {
+		case int:
+			b.WriteString(strconv.Itoa(v))
+		case string:
+			b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(v))
+		}
+	}
+	return b.String()
+}
+
+// formatPath formats path with f, FormatPathYQ is used if f is nil.
+func formatPath(f PathFormat, path []interface{}) string {
+	if f == nil {
+		return pathToString(path)
+	}
+	return f(path)
+}
+
+// escapeKey escapes backslashes and quotes in a quoted key.
+func escapeKey(k string, quote byte) string {
+	return strings.NewReplacer(`\`, `\\`, string(quote), `\`+string(quote)).Replace(k)
+}
+
+// withPathFormat sets path format of errors.
+func withPathFormat(err error, f PathFormat) error {
+	return mapErrors(err, func(e ErrGomatch) ErrGomatch {
+		e.format = f
+		return e
+	})
+}
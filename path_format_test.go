@@ -0,0 +1,102 @@
+package gomatch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathFormats(t *testing.T) {
+	tests := []struct {
+		desc    string
+		path    []interface{}
+		yq      string
+		jsonPth string
+		pointer string
+	}{
+		{"root", []interface{}{}, ".", "$", ""},
+		{"keys and indexes", []interface{}{"items", 0, "price"}, ".items[0].price", "$.items[0].price", "/items/0/price"},
+		{"root index", []interface{}{1, "a"}, ".[1].a", "$[1].a", "/1/a"},
+		{"dot", []interface{}{"a.b", "c"}, `.["a.b"].c`, "$['a.b'].c", "/a.b/c"},
+		{"space", []interface{}{"first name"}, `.["first name"]`, "$['first name']", "/first name"},
+		{"hyphen", []interface{}{"headers", "content-type"}, `.headers["content-type"]`, "$.headers['content-type']", "/headers/content-type"},
+		{"numeric key", []interface{}{"0"}, `.["0"]`, "$['0']", "/0"},
+		{"quotes", []interface{}{`say "hi"`, "it's"}, `.["say \"hi\""]["it's"]`, `$['say "hi"']['it\'s']`, `/say "hi"/it's`},
+		{"pointer escapes", []interface{}{"a/b", "m~n"}, `.["a/b"]["m~n"]`, "$['a/b']['m~n']", "/a~1b/m~0n"},
+		{"backslash", []interface{}{`a\b`}, `.["a\\b"]`, `$['a\\b']`, `/a\b`},
+		{"empty key", []interface{}{""}, `.[""]`, "$['']", "/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.yq, FormatPathYQ(tt.path))
+			assert.Equal(t, tt.jsonPth, FormatPathJSONPath(tt.path))
+			assert.Equal(t, tt.pointer, FormatPathJSONPointer(tt.path))
+
+			if len(tt.path) > 0 {
+				parsed, err := parsePath(FormatPathYQ(tt.path))
+				assert.Nil(t, err)
+				assert.Equal(t, tt.path, parsed)
+			}
+		})
+	}
+}
+
+func TestJSONMatcherFormatPaths(t *testing.T) {
+	p := `{"headers": {"content-type": "text/plain"}, "payment": "@union('type', {\"card\": {\"number\": \"@pan@\"}})@"}`
+	v := `{"headers": {"content-type": "text/html"}, "payment": {"type": "card", "number": "1"}}`
+
+	m := NewDefaultJSONMatcher()
+	_, err := m.Match(p, v)
+	assert.Contains(t, err.Error(), `values are not equal at ".headers[\"content-type\"]"`)
+
+	m.FormatPaths(FormatPathJSONPointer)
+	_, err = m.Match(p, v)
+	assert.Contains(t, err.Error(), `values are not equal at "/headers/content-type"`)
+	assert.Contains(t, err.Error(), `expected card number at "/payment/number"`)
+
+	m.FormatPaths(FormatPathJSONPath)
+	_, err = m.Match(p, v)
+	assert.Contains(t, err.Error(), `values are not equal at "$.headers['content-type']"`)
+	assert.Contains(t, err.Error(), `expected card number at "$.payment.number"`)
+
+	_, err = m.Match(`{"a": 1, "b": 2, "@assert@": "a > b"}`, `{"a": 1, "b": 2}`)
+	assert.Contains(t, err.Error(), `involving ["$.a" "$.b"]`)
+
+	_, err = m.MatchParams(`{"a.b": "${x}"}`, `{"a.b": 1}`, ParamsMap(nil))
+	assert.Contains(t, err.Error(), `unresolved parameter "x" at "$['a.b']"`)
+
+	r, err := m.MatchResult(`{"a": {"b c": "@number@"}}`, `{"a": {"b c": 1}}`)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"$.a['b c']": 1.0}, r.Captures)
+}
+
+func TestJSONMatcherFormatPathsInReports(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	m.FormatPaths(FormatPathJSONPointer)
+
+	p := `{"a": "@union('t', {\"x\": {\"b c\": 1}})@", "d": "@expr(path == '/d')@"}`
+	r, err := m.MatchResult(p, `{"a": {"t": "x", "b c": 2}, "d": 3}`)
+	assert.Nil(t, err)
+	assert.Len(t, r.Mismatches, 1)
+	assert.Contains(t, r.Tree().String(), "  /a (1 problem)\n    /a/b c (1 problem)\n")
+
+	var b strings.Builder
+	assert.Nil(t, NewJSONReporter(&b).Report(r))
+	assert.Contains(t, b.String(), `"path":"/a/b c"`)
+
+	b.Reset()
+	junit := NewJUnitReporter(&b, "suite")
+	junit.Add("case", r)
+	assert.Nil(t, junit.Flush())
+	assert.Contains(t, b.String(), "/a/b c (/a/b c) value_mismatch")
+
+	b.Reset()
+	assert.Nil(t, NewDiffReporter(&b).Report(r))
+	assert.Contains(t, b.String(), "/a/b c: values are not equal")
+
+	diagnostics := m.Lint(`{"a": ["@strnig@"]}`)
+	assert.Len(t, diagnostics, 1)
+	assert.Contains(t, diagnostics[0].Error(), `at "/a/0"`)
+}
@@ -0,0 +1,52 @@
+package gomatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var parsePathTests = []struct {
+	desc string
+	s    string
+	path []interface{}
+	ok   bool
+}{
+	{"Should parse root", ".", []interface{}{}, true},
+	{"Should parse keys", ".a.b", []interface{}{"a", "b"}, true},
+	{"Should parse indexes", ".items[0][1].price", []interface{}{"items", 0, 1, "price"}, true},
+	{"Should parse root index", ".[2]", []interface{}{2}, true},
+	{"Should parse quoted keys", `."a.b"["c d"].e`, []interface{}{"a.b", "c d", "e"}, true},
+	{"Should fail without leading dot", "a.b", nil, false},
+	{"Should fail on invalid index", ".a[x]", nil, false},
+	{"Should fail on trailing dot", ".a.", nil, false},
+	{"Should fail on unterminated key", `."a`, nil, false},
+}
+
+func TestParsePath(t *testing.T) {
+	for _, tt := range parsePathTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			path, err := parsePath(tt.s)
+			if tt.ok {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.path, path)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLookupPath(t *testing.T) {
+	doc := map[string]interface{}{"items": []interface{}{map[string]interface{}{"price": 10.}}}
+
+	v, ok := lookupPath(doc, []interface{}{"items", 0, "price"})
+	assert.True(t, ok)
+	assert.Equal(t, 10., v)
+
+	_, ok = lookupPath(doc, []interface{}{"items", 1, "price"})
+	assert.False(t, ok)
+
+	_, ok = lookupPath(doc, []interface{}{"items", "price"})
+	assert.False(t, ok)
+}
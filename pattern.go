@@ -0,0 +1,168 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var ErrInvalidPattern = errors.New("invalid pattern")
+
+// A patternCall is a constraint appended to a value pattern, e.g. `.inSubnet("10.0.0.0/8")`.
+type patternCall struct {
+	name string
+	args []string
+}
+
+// hasPattern returns true if p is the pattern itself or the pattern followed by constraints.
+func hasPattern(p interface{}, pattern string) bool {
+	ps, ok := p.(string)
+	if !ok || !strings.HasPrefix(ps, pattern) {
+		return false
+	}
+	rest := ps[len(pattern):]
+	return rest == "" || rest[0] == '.'
+}
+
+// patternCalls parses constraints following the pattern in p.
+//
+// Constraints are written as method calls: `@ip@.inSubnet("10.0.0.0/8")`.
+// Arguments are separated by commas and may be quoted with double or single quotes.
+func patternCalls(p interface{}, pattern string) ([]patternCall, error) {
+	ps, _ := p.(string)
+	rest := strings.TrimPrefix(ps, pattern)
+	calls := []patternCall{}
+	for rest != "" {
+		if rest[0] != '.' {
+			return nil, fmt.Errorf("%w %q: expected '.' before constraint", ErrInvalidPattern, ps)
+		}
+		open := strings.IndexByte(rest, '(')
+		if open < 0 {
+			return nil, fmt.Errorf("%w %q: expected '(' after constraint name", ErrInvalidPattern, ps)
+		}
+		name := rest[1:open]
+		if name == "" {
+			return nil, fmt.Errorf("%w %q: empty constraint name", ErrInvalidPattern, ps)
+		}
+		args, n, err := parseCallArgs(rest[open+1:])
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %s", ErrInvalidPattern, ps, err)
+		}
+		calls = append(calls, patternCall{name, args})
+		rest = rest[open+1+n:]
+	}
+	return calls, nil
+}
+
+// patternFunc returns arguments of a function-like pattern, e.g. `@ci("paid")@`.
+// The arguments are returned unparsed.
+func patternFunc(p interface{}, name string) (string, bool) {
+	ps, ok := p.(string)
+	prefix := "@" + name + "("
+	if !ok || !strings.HasPrefix(ps, prefix) || !strings.HasSuffix(ps, ")@") || len(ps) < len(prefix)+2 {
+		return "", false
+	}
+	return ps[len(prefix) : len(ps)-2], true
+}
+
+// patternFuncArg returns the single, optionally quoted, argument of a function-like pattern.
+func patternFuncArg(p interface{}, name string) (string, error) {
+	args, _ := patternFunc(p, name)
+	parsed, n, err := parseCallArgs(args + ")")
+	if err != nil || n != len(args)+1 || len(parsed) != 1 {
+		return "", fmt.Errorf("%w %q: expected single argument", ErrInvalidPattern, p)
+	}
+	return parsed[0], nil
+}
+
+// parseCallArgs parses comma separated arguments up to the closing parenthesis.
+// It returns the arguments and the number of consumed bytes including the parenthesis.
+func parseCallArgs(s string) ([]string, int, error) {
+	args := []string{}
+	var b strings.Builder
+	quote := byte(0)
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0 && c == '\\' && i+1 < len(s):
+			i++
+			b.WriteByte(s[i])
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote != 0:
+			b.WriteByte(c)
+		case c == '"' || c == '\'':
+			if quoted || strings.TrimSpace(b.String()) != "" {
+				return nil, 0, fmt.Errorf("unexpected %q inside argument", c)
+			}
+			b.Reset()
+			quote = c
+			quoted = true
+		case c == ',' || c == ')':
+			arg := b.String()
+			if !quoted {
+				arg = strings.TrimSpace(arg)
+			}
+			if arg != "" || quoted || c == ',' || len(args) > 0 {
+				args = append(args, arg)
+			}
+			if c == ')' {
+				return args, i + 1, nil
+			}
+			b.Reset()
+			quoted = false
+		case quoted && c != ' ':
+			return nil, 0, fmt.Errorf("unexpected %q after quoted argument", c)
+		case quoted:
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return nil, 0, errors.New("missing ')'")
+}
+
+// validatePatternCalls parses constraints following the pattern in p and validates each of them.
+func validatePatternCalls(p, pattern string, validate func(c patternCall) error) error {
+	calls, err := patternCalls(p, pattern)
+	if err != nil {
+		return err
+	}
+	for _, c := range calls {
+		if err := validate(c); err != nil {
+			return fmt.Errorf("%w in %q", err, p)
+		}
+	}
+	return nil
+}
+
+// validateFlagCall validates constraint c which may be one of flags without arguments, e.g. `.stable()`.
+func validateFlagCall(c patternCall, flags ...string) error {
+	switch {
+	case !slices.Contains(flags, c.name):
+		return errUnknownConstraint(c)
+	case len(c.args) != 0:
+		return errConstraintArgs(c, 0)
+	}
+	return nil
+}
+
+func errUnknownConstraint(c patternCall) error {
+	return fmt.Errorf("%w: unknown constraint %q", ErrInvalidPattern, c.name)
+}
+
+func errConstraintArgs(c patternCall, want int) error {
+	return fmt.Errorf("%w: constraint %q expects %d argument(s), got %d", ErrInvalidPattern, c.name, want, len(c.args))
+}
+
+// splitAlternatives splits constraint arguments written as "a|b" or as separate arguments.
+func splitAlternatives(args []string) []string {
+	alts := []string{}
+	for _, arg := range args {
+		for _, a := range strings.Split(arg, "|") {
+			alts = append(alts, strings.TrimSpace(a))
+		}
+	}
+	return alts
+}
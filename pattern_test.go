@@ -0,0 +1,76 @@
+package gomatch
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var patternCallsTests = []struct {
+	desc  string
+	p     string
+	calls []patternCall
+	err   error
+}{
+	{
+		"Should parse pattern without constraints",
+		"@ip@",
+		[]patternCall{},
+		nil,
+	},
+	{
+		"Should parse constraint with quoted argument",
+		`@ip@.inSubnet("10.0.0.0/8")`,
+		[]patternCall{{"inSubnet", []string{"10.0.0.0/8"}}},
+		nil,
+	},
+	{
+		"Should fail on space between constraints",
+		`@x@.a('1, 2', "it's") .b(3 , 4).c()`,
+		nil,
+		ErrInvalidPattern,
+	},
+	{
+		"Should parse chained constraints",
+		`@x@.a('1, 2', "it\"s").b(3 , 4).c()`,
+		[]patternCall{{"a", []string{"1, 2", `it"s`}}, {"b", []string{"3", "4"}}, {"c", []string{}}},
+		nil,
+	},
+	{
+		"Should fail on missing parenthesis",
+		`@x@.a('1'`,
+		nil,
+		ErrInvalidPattern,
+	},
+	{
+		"Should fail on missing constraint name",
+		`@x@.(1)`,
+		nil,
+		ErrInvalidPattern,
+	},
+}
+
+func TestPatternCalls(t *testing.T) {
+	for _, tt := range patternCallsTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			pattern := tt.p[:strings.Index(tt.p[1:], "@")+2]
+			calls, err := patternCalls(tt.p, pattern)
+			if tt.err == nil {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.calls, calls)
+			} else {
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
+
+func TestHasPattern(t *testing.T) {
+	assert.True(t, hasPattern("@ip@", "@ip@"))
+	assert.True(t, hasPattern("@ip@.inSubnet('10.0.0.0/8')", "@ip@"))
+	assert.False(t, hasPattern("@ipv4@", "@ip@"))
+	assert.False(t, hasPattern("@ip@x", "@ip@"))
+	assert.False(t, hasPattern(1, "@ip@"))
+}
@@ -0,0 +1,172 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrNotPhone      = errors.New("expected phone number")
+	ErrPhoneCountry  = errors.New("expected phone number of country")
+	ErrPhoneNotEqual = errors.New("expected phone number equal to")
+)
+
+// A PhoneMatcher matches phone numbers in E.164 format, e.g. "+421900123456".
+// Numbers may contain common separators: spaces, dashes, dots, slashes and parentheses,
+// international prefix "00" is accepted instead of "+".
+//
+// It supports optional constraints:
+//
+//	"@phone@.country(SK|CZ)"            - number of one of given countries
+//	"@phone@.e164()"                    - strict E.164 format without separators
+//	"@phone@.equals('+421 900 123 456')" - number equal to given number after normalisation
+//
+// When a single country is given, numbers in national format, e.g. "0900 123 456", are accepted as well.
+// Countries sharing a calling code, e.g. US and CA, cannot be distinguished.
+type PhoneMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *PhoneMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *PhoneMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	countries, nationalRegion := phoneCountries(calls)
+	s, ok := v.(string)
+	if !ok {
+		return false, ErrNotPhone
+	}
+	number, meta, ok := normalizePhone(s, nationalRegion)
+	if !ok {
+		return false, ErrNotPhone
+	}
+	for _, c := range calls {
+		switch c.name {
+		case "country":
+			if !slices.ContainsFunc(countries, func(r string) bool { return slices.Contains(meta.regions, r) }) {
+				return false, fmt.Errorf("%w %s", ErrPhoneCountry, strings.Join(countries, "|"))
+			}
+		case "e164":
+			if len(c.args) != 0 {
+				return false, errConstraintArgs(c, 0)
+			}
+			if s != number {
+				return false, fmt.Errorf("%w in E.164 format %s", ErrNotPhone, number)
+			}
+		case "equals":
+			if len(c.args) != 1 {
+				return false, errConstraintArgs(c, 1)
+			}
+			expected, _, ok := normalizePhone(c.args[0], nationalRegion)
+			if !ok {
+				return false, fmt.Errorf("%w: invalid phone number %q", ErrInvalidPattern, c.args[0])
+			}
+			if number != expected {
+				return false, fmt.Errorf("%w %s", ErrPhoneNotEqual, expected)
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *PhoneMatcher) ValidatePattern(p string) error {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return err
+	}
+	_, nationalRegion := phoneCountries(calls)
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		switch c.name {
+		case "country":
+			if len(c.args) == 0 {
+				return errConstraintArgs(c, 1)
+			}
+			return nil
+		case "equals":
+			if len(c.args) != 1 {
+				return errConstraintArgs(c, 1)
+			}
+			if _, _, ok := normalizePhone(c.args[0], nationalRegion); !ok {
+				return fmt.Errorf("%w: invalid phone number %q", ErrInvalidPattern, c.args[0])
+			}
+			return nil
+		}
+		return validateFlagCall(c, "e164")
+	})
+}
+
+// phoneCountries returns countries of country constraints and the region of national numbers,
+// which is set only if a single country is given.
+func phoneCountries(calls []patternCall) ([]string, string) {
+	countries := []string{}
+	for _, c := range calls {
+		if c.name == "country" {
+			countries = append(countries, splitAlternatives(c.args)...)
+		}
+	}
+	if len(countries) == 1 {
+		return countries, countries[0]
+	}
+	return countries, ""
+}
+
+// normalizePhone converts a phone number to E.164 format.
+// National numbers are accepted only when region is given.
+func normalizePhone(s, region string) (string, phoneMetadata, bool) {
+	digits := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(" -./()", r) {
+			return -1
+		}
+		return r
+	}, s)
+	switch {
+	case strings.HasPrefix(digits, "+"):
+		digits = digits[1:]
+	case strings.HasPrefix(digits, "00"):
+		digits = digits[2:]
+	case region != "":
+		i := slices.IndexFunc(phoneMetadataTable, func(m phoneMetadata) bool { return slices.Contains(m.regions, region) })
+		if i < 0 {
+			return "", phoneMetadata{}, false
+		}
+		meta := phoneMetadataTable[i]
+		digits = meta.callingCode + strings.TrimPrefix(digits, meta.trunkPrefix)
+	default:
+		return "", phoneMetadata{}, false
+	}
+	if digits == "" || strings.Trim(digits, "0123456789") != "" || len(digits) > 15 {
+		return "", phoneMetadata{}, false
+	}
+	for _, meta := range phoneMetadataTable {
+		nsn, ok := strings.CutPrefix(digits, meta.callingCode)
+		if !ok {
+			continue
+		}
+		minLength, maxLength := meta.minLength, meta.maxLength
+		if maxLength == 0 {
+			minLength, maxLength = 4, 15-len(meta.callingCode)
+		}
+		if len(nsn) < minLength || len(nsn) > maxLength || nsn[0] == '0' && meta.trunkPrefix == "0" {
+			return "", phoneMetadata{}, false
+		}
+		return "+" + digits, meta, true
+	}
+	return "", phoneMetadata{}, false
+}
+
+// NewPhoneMatcher creates PhoneMatcher.
+func NewPhoneMatcher(pattern string) *PhoneMatcher {
+	return &PhoneMatcher{pattern}
+}
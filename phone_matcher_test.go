@@ -0,0 +1,169 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var phoneMatcherTests = []struct {
+	desc string
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match E.164 number",
+		"@pattern@",
+		"+421900123456",
+		true,
+		nil,
+	},
+	{
+		"Should match number with separators",
+		"@pattern@",
+		"+421 900 123 456",
+		true,
+		nil,
+	},
+	{
+		"Should match number with international prefix",
+		"@pattern@",
+		"00421 900 123 456",
+		true,
+		nil,
+	},
+	{
+		"Should match US number",
+		"@pattern@",
+		"+1 (212) 555-0100",
+		true,
+		nil,
+	},
+	{
+		"Should not match national number without country",
+		"@pattern@",
+		"0900 123 456",
+		false,
+		ErrNotPhone,
+	},
+	{
+		"Should not match number of invalid length",
+		"@pattern@",
+		"+42190012345",
+		false,
+		ErrNotPhone,
+	},
+	{
+		"Should not match unknown calling code",
+		"@pattern@",
+		"+999123456789",
+		false,
+		ErrNotPhone,
+	},
+	{
+		"Should not match letters",
+		"@pattern@",
+		"+421 900 CALL ME",
+		false,
+		ErrNotPhone,
+	},
+	{
+		"Should not match number",
+		"@pattern@",
+		421900123456.,
+		false,
+		ErrNotPhone,
+	},
+	{
+		"Should match number of country",
+		"@pattern@.country(SK|CZ)",
+		"+420 601 123 456",
+		true,
+		nil,
+	},
+	{
+		"Should not match number of other country",
+		"@pattern@.country(SK)",
+		"+420 601 123 456",
+		false,
+		ErrPhoneCountry,
+	},
+	{
+		"Should match national number of country",
+		"@pattern@.country(SK)",
+		"0900 123 456",
+		true,
+		nil,
+	},
+	{
+		"Should match strict E.164 number",
+		"@pattern@.e164()",
+		"+421900123456",
+		true,
+		nil,
+	},
+	{
+		"Should not match number with separators as strict E.164",
+		"@pattern@.e164()",
+		"+421 900 123 456",
+		false,
+		ErrNotPhone,
+	},
+	{
+		"Should match equal number",
+		"@pattern@.equals('+421 900 123 456')",
+		"+421900123456",
+		true,
+		nil,
+	},
+	{
+		"Should match equal national number",
+		"@pattern@.country(SK).equals('+421900123456')",
+		"0900/123 456",
+		true,
+		nil,
+	},
+	{
+		"Should not match different number",
+		"@pattern@.equals('+421 900 123 456')",
+		"+421900123457",
+		false,
+		ErrPhoneNotEqual,
+	},
+	{
+		"Should fail on invalid expected number",
+		"@pattern@.equals('123')",
+		"+421900123457",
+		false,
+		ErrInvalidPattern,
+	},
+}
+
+func TestPhoneMatcher(t *testing.T) {
+	for _, tt := range phoneMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewPhoneMatcher("@pattern@")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
+
+func TestPhoneMatcherNotEqualMessage(t *testing.T) {
+	m := NewPhoneMatcher("@pattern@")
+
+	_, err := m.Match("@pattern@.equals('+421 900 123 456')", "+421900123457")
+	assert.EqualError(t, err, "expected phone number equal to +421900123456")
+	assert.False(t, errors.Is(err, ErrNotPhone))
+}
@@ -0,0 +1,222 @@
+package gomatch
+
+// A phoneMetadata describes numbering plan of a country calling code.
+type phoneMetadata struct {
+	callingCode string
+	regions     []string
+	// trunkPrefix is dialled before a national number within the country.
+	trunkPrefix string
+	// minLength and maxLength of the national significant number, zero when unknown.
+	minLength, maxLength int
+}
+
+// phoneMetadataTable lists ITU-T E.164 country calling codes.
+// Calling codes shared by multiple regions, e.g. +1, list all of them.
+var phoneMetadataTable = []phoneMetadata{
+	{"1", []string{"US", "CA", "AG", "AI", "AS", "BB", "BM", "BS", "DM", "DO", "GD", "GU", "JM", "KN", "KY", "LC", "MP", "MS", "PR", "SX", "TC", "TT", "VC", "VG", "VI"}, "1", 10, 10},
+	{"7", []string{"RU", "KZ"}, "8", 10, 10},
+	{"20", []string{"EG"}, "0", 8, 10},
+	{"211", []string{"SS"}, "0", 0, 0},
+	{"212", []string{"MA", "EH"}, "0", 9, 9},
+	{"213", []string{"DZ"}, "0", 8, 9},
+	{"216", []string{"TN"}, "", 0, 0},
+	{"218", []string{"LY"}, "0", 0, 0},
+	{"220", []string{"GM"}, "", 0, 0},
+	{"221", []string{"SN"}, "", 0, 0},
+	{"222", []string{"MR"}, "", 0, 0},
+	{"223", []string{"ML"}, "", 0, 0},
+	{"224", []string{"GN"}, "", 0, 0},
+	{"225", []string{"CI"}, "", 0, 0},
+	{"226", []string{"BF"}, "", 0, 0},
+	{"227", []string{"NE"}, "", 0, 0},
+	{"228", []string{"TG"}, "", 0, 0},
+	{"229", []string{"BJ"}, "", 0, 0},
+	{"230", []string{"MU"}, "", 0, 0},
+	{"231", []string{"LR"}, "0", 0, 0},
+	{"232", []string{"SL"}, "0", 0, 0},
+	{"233", []string{"GH"}, "0", 9, 9},
+	{"234", []string{"NG"}, "0", 8, 10},
+	{"235", []string{"TD"}, "", 0, 0},
+	{"236", []string{"CF"}, "", 0, 0},
+	{"237", []string{"CM"}, "", 0, 0},
+	{"238", []string{"CV"}, "", 0, 0},
+	{"239", []string{"ST"}, "", 0, 0},
+	{"240", []string{"GQ"}, "", 0, 0},
+	{"241", []string{"GA"}, "", 0, 0},
+	{"242", []string{"CG"}, "", 0, 0},
+	{"243", []string{"CD"}, "0", 0, 0},
+	{"244", []string{"AO"}, "", 0, 0},
+	{"245", []string{"GW"}, "", 0, 0},
+	{"246", []string{"IO"}, "", 0, 0},
+	{"247", []string{"AC"}, "", 0, 0},
+	{"248", []string{"SC"}, "", 0, 0},
+	{"249", []string{"SD"}, "0", 0, 0},
+	{"250", []string{"RW"}, "0", 0, 0},
+	{"251", []string{"ET"}, "0", 0, 0},
+	{"252", []string{"SO"}, "0", 0, 0},
+	{"253", []string{"DJ"}, "", 0, 0},
+	{"254", []string{"KE"}, "0", 9, 9},
+	{"255", []string{"TZ"}, "0", 9, 9},
+	{"256", []string{"UG"}, "0", 9, 9},
+	{"257", []string{"BI"}, "", 0, 0},
+	{"258", []string{"MZ"}, "", 0, 0},
+	{"260", []string{"ZM"}, "0", 0, 0},
+	{"261", []string{"MG"}, "0", 0, 0},
+	{"262", []string{"RE", "YT"}, "0", 0, 0},
+	{"263", []string{"ZW"}, "0", 0, 0},
+	{"264", []string{"NA"}, "0", 0, 0},
+	{"265", []string{"MW"}, "0", 0, 0},
+	{"266", []string{"LS"}, "", 0, 0},
+	{"267", []string{"BW"}, "", 0, 0},
+	{"268", []string{"SZ"}, "", 0, 0},
+	{"269", []string{"KM"}, "", 0, 0},
+	{"27", []string{"ZA"}, "0", 9, 9},
+	{"290", []string{"SH"}, "", 0, 0},
+	{"291", []string{"ER"}, "0", 0, 0},
+	{"297", []string{"AW"}, "", 0, 0},
+	{"298", []string{"FO"}, "", 0, 0},
+	{"299", []string{"GL"}, "", 0, 0},
+	{"30", []string{"GR"}, "", 10, 10},
+	{"31", []string{"NL"}, "0", 9, 9},
+	{"32", []string{"BE"}, "0", 8, 9},
+	{"33", []string{"FR"}, "0", 9, 9},
+	{"34", []string{"ES"}, "", 9, 9},
+	{"350", []string{"GI"}, "", 0, 0},
+	{"351", []string{"PT"}, "", 9, 9},
+	{"352", []string{"LU"}, "", 0, 0},
+	{"353", []string{"IE"}, "0", 7, 9},
+	{"354", []string{"IS"}, "", 0, 0},
+	{"355", []string{"AL"}, "0", 0, 0},
+	{"356", []string{"MT"}, "", 0, 0},
+	{"357", []string{"CY"}, "", 0, 0},
+	{"358", []string{"FI", "AX"}, "0", 5, 12},
+	{"359", []string{"BG"}, "0", 0, 0},
+	{"36", []string{"HU"}, "06", 8, 9},
+	{"370", []string{"LT"}, "8", 0, 0},
+	{"371", []string{"LV"}, "", 0, 0},
+	{"372", []string{"EE"}, "", 0, 0},
+	{"373", []string{"MD"}, "0", 0, 0},
+	{"374", []string{"AM"}, "0", 0, 0},
+	{"375", []string{"BY"}, "8", 0, 0},
+	{"376", []string{"AD"}, "", 0, 0},
+	{"377", []string{"MC"}, "0", 0, 0},
+	{"378", []string{"SM"}, "", 0, 0},
+	{"380", []string{"UA"}, "0", 9, 9},
+	{"381", []string{"RS"}, "0", 0, 0},
+	{"382", []string{"ME"}, "0", 0, 0},
+	{"383", []string{"XK"}, "0", 0, 0},
+	{"385", []string{"HR"}, "0", 0, 0},
+	{"386", []string{"SI"}, "0", 0, 0},
+	{"387", []string{"BA"}, "0", 0, 0},
+	{"389", []string{"MK"}, "0", 0, 0},
+	{"39", []string{"IT", "VA"}, "", 6, 11},
+	{"40", []string{"RO"}, "0", 9, 9},
+	{"41", []string{"CH"}, "0", 9, 9},
+	{"420", []string{"CZ"}, "", 9, 9},
+	{"421", []string{"SK"}, "0", 9, 9},
+	{"423", []string{"LI"}, "", 0, 0},
+	{"43", []string{"AT"}, "0", 4, 13},
+	{"44", []string{"GB", "GG", "IM", "JE"}, "0", 7, 10},
+	{"45", []string{"DK"}, "", 8, 8},
+	{"46", []string{"SE"}, "0", 7, 10},
+	{"47", []string{"NO", "SJ"}, "", 8, 8},
+	{"48", []string{"PL"}, "", 9, 9},
+	{"49", []string{"DE"}, "0", 5, 13},
+	{"500", []string{"FK"}, "", 0, 0},
+	{"501", []string{"BZ"}, "", 0, 0},
+	{"502", []string{"GT"}, "", 0, 0},
+	{"503", []string{"SV"}, "", 0, 0},
+	{"504", []string{"HN"}, "", 0, 0},
+	{"505", []string{"NI"}, "", 0, 0},
+	{"506", []string{"CR"}, "", 0, 0},
+	{"507", []string{"PA"}, "", 0, 0},
+	{"508", []string{"PM"}, "0", 0, 0},
+	{"509", []string{"HT"}, "", 0, 0},
+	{"51", []string{"PE"}, "0", 8, 9},
+	{"52", []string{"MX"}, "", 10, 10},
+	{"53", []string{"CU"}, "0", 0, 0},
+	{"54", []string{"AR"}, "0", 10, 11},
+	{"55", []string{"BR"}, "0", 10, 11},
+	{"56", []string{"CL"}, "", 9, 9},
+	{"57", []string{"CO"}, "0", 8, 10},
+	{"58", []string{"VE"}, "0", 10, 10},
+	{"590", []string{"GP", "BL", "MF"}, "0", 0, 0},
+	{"591", []string{"BO"}, "0", 0, 0},
+	{"592", []string{"GY"}, "", 0, 0},
+	{"593", []string{"EC"}, "0", 0, 0},
+	{"594", []string{"GF"}, "0", 0, 0},
+	{"595", []string{"PY"}, "0", 0, 0},
+	{"596", []string{"MQ"}, "0", 0, 0},
+	{"597", []string{"SR"}, "", 0, 0},
+	{"598", []string{"UY"}, "0", 0, 0},
+	{"599", []string{"CW", "BQ"}, "0", 0, 0},
+	{"60", []string{"MY"}, "0", 8, 10},
+	{"61", []string{"AU", "CC", "CX"}, "0", 9, 9},
+	{"62", []string{"ID"}, "0", 8, 12},
+	{"63", []string{"PH"}, "0", 10, 10},
+	{"64", []string{"NZ"}, "0", 8, 10},
+	{"65", []string{"SG"}, "", 8, 8},
+	{"66", []string{"TH"}, "0", 8, 9},
+	{"670", []string{"TL"}, "", 0, 0},
+	{"672", []string{"NF"}, "", 0, 0},
+	{"673", []string{"BN"}, "", 0, 0},
+	{"674", []string{"NR"}, "", 0, 0},
+	{"675", []string{"PG"}, "", 0, 0},
+	{"676", []string{"TO"}, "", 0, 0},
+	{"677", []string{"SB"}, "", 0, 0},
+	{"678", []string{"VU"}, "", 0, 0},
+	{"679", []string{"FJ"}, "", 0, 0},
+	{"680", []string{"PW"}, "", 0, 0},
+	{"681", []string{"WF"}, "", 0, 0},
+	{"682", []string{"CK"}, "", 0, 0},
+	{"683", []string{"NU"}, "", 0, 0},
+	{"685", []string{"WS"}, "", 0, 0},
+	{"686", []string{"KI"}, "0", 0, 0},
+	{"687", []string{"NC"}, "", 0, 0},
+	{"688", []string{"TV"}, "", 0, 0},
+	{"689", []string{"PF"}, "", 0, 0},
+	{"690", []string{"TK"}, "", 0, 0},
+	{"691", []string{"FM"}, "", 0, 0},
+	{"692", []string{"MH"}, "1", 0, 0},
+	{"81", []string{"JP"}, "0", 9, 10},
+	{"82", []string{"KR"}, "0", 8, 10},
+	{"84", []string{"VN"}, "0", 9, 10},
+	{"850", []string{"KP"}, "0", 0, 0},
+	{"852", []string{"HK"}, "", 8, 8},
+	{"853", []string{"MO"}, "", 0, 0},
+	{"855", []string{"KH"}, "0", 0, 0},
+	{"856", []string{"LA"}, "0", 0, 0},
+	{"86", []string{"CN"}, "0", 9, 11},
+	{"880", []string{"BD"}, "0", 0, 0},
+	{"886", []string{"TW"}, "0", 0, 0},
+	{"90", []string{"TR"}, "0", 10, 10},
+	{"91", []string{"IN"}, "0", 10, 10},
+	{"92", []string{"PK"}, "0", 9, 10},
+	{"93", []string{"AF"}, "0", 9, 9},
+	{"94", []string{"LK"}, "0", 9, 9},
+	{"95", []string{"MM"}, "0", 0, 0},
+	{"960", []string{"MV"}, "", 0, 0},
+	{"961", []string{"LB"}, "0", 0, 0},
+	{"962", []string{"JO"}, "0", 0, 0},
+	{"963", []string{"SY"}, "0", 0, 0},
+	{"964", []string{"IQ"}, "0", 0, 0},
+	{"965", []string{"KW"}, "", 0, 0},
+	{"966", []string{"SA"}, "0", 9, 9},
+	{"967", []string{"YE"}, "0", 0, 0},
+	{"968", []string{"OM"}, "", 0, 0},
+	{"970", []string{"PS"}, "0", 0, 0},
+	{"971", []string{"AE"}, "0", 8, 9},
+	{"972", []string{"IL"}, "0", 8, 9},
+	{"973", []string{"BH"}, "", 0, 0},
+	{"974", []string{"QA"}, "", 0, 0},
+	{"975", []string{"BT"}, "", 0, 0},
+	{"976", []string{"MN"}, "0", 0, 0},
+	{"977", []string{"NP"}, "0", 0, 0},
+	{"98", []string{"IR"}, "0", 10, 10},
+	{"992", []string{"TJ"}, "8", 0, 0},
+	{"993", []string{"TM"}, "8", 0, 0},
+	{"994", []string{"AZ"}, "0", 0, 0},
+	{"995", []string{"GE"}, "0", 0, 0},
+	{"996", []string{"KG"}, "0", 0, 0},
+	{"998", []string{"UZ"}, "8", 0, 0},
+}
@@ -0,0 +1,46 @@
+package gomatch
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+var ErrNotPort = errors.New("expected port")
+
+// A PortMatcher matches port numbers in range 0-65535.
+// It accepts both JSON numbers and numeric strings.
+type PortMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *PortMatcher) CanMatch(p interface{}) bool {
+	return isPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *PortMatcher) Match(p, v interface{}) (bool, error) {
+	var port float64
+	switch a := v.(type) {
+	case float64:
+		port = a
+	case string:
+		n, err := strconv.ParseUint(a, 10, 16)
+		if err != nil {
+			return false, ErrNotPort
+		}
+		port = float64(n)
+	default:
+		return false, ErrNotPort
+	}
+	if port < 0 || port > math.MaxUint16 || port != math.Trunc(port) {
+		return false, ErrNotPort
+	}
+	return true, nil
+}
+
+// NewPortMatcher creates PortMatcher.
+func NewPortMatcher(pattern string) *PortMatcher {
+	return &PortMatcher{pattern}
+}
@@ -0,0 +1,72 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var portMatcherTests = []struct {
+	desc string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match number",
+		8080.,
+		true,
+		nil,
+	},
+	{
+		"Should match numeric string",
+		"443",
+		true,
+		nil,
+	},
+	{
+		"Should not match port out of range",
+		65536.,
+		false,
+		ErrNotPort,
+	},
+	{
+		"Should not match negative number",
+		-1.,
+		false,
+		ErrNotPort,
+	},
+	{
+		"Should not match fraction",
+		80.5,
+		false,
+		ErrNotPort,
+	},
+	{
+		"Should not match non numeric string",
+		"http",
+		false,
+		ErrNotPort,
+	},
+}
+
+func TestPortMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range portMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewPortMatcher(pattern)
+			assert.True(t, m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
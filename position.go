@@ -0,0 +1,193 @@
+package gomatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// A Position is a location of a value in JSON source.
+type Position struct {
+	// File is a name of the source file, empty if unknown.
+	File string
+
+	// Line and Column start at 1, Column counts bytes.
+	Line   int
+	Column int
+}
+
+// IsValid returns true if the position is known.
+func (p Position) IsValid() bool {
+	return p.Line > 0
+}
+
+// String formats the position as "file:line:column", or "line:column" if the file is unknown.
+func (p Position) String() string {
+	if p.File == "" {
+		return fmt.Sprintf("%d:%d", p.Line, p.Column)
+	}
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+}
+
+// Filenames sets names of files the expected and actual JSONs were read from.
+// The names are used in positions of mismatching values, errors of the values then start with
+// "file:line:column" instead of "line:column" so editors and test output can link them.
+func (m *JSONMatcher) Filenames(expected, actual string) {
+	m.expectedFile, m.actualFile = expected, actual
+}
+
+// sourcePositions holds offsets of values in JSON source.
+type sourcePositions struct {
+	file       string
+	offsets    map[string]int
+	lineStarts []int
+}
+
+// scanPositions records offsets of all values in valid JSON data, keyed by their JSON Pointers.
+func scanPositions(data []byte, file string) *sourcePositions {
+	s := &sourcePositions{file: file, offsets: map[string]int{}, lineStarts: []int{0}}
+	for i, c := range data {
+		if c == '\n' {
+			s.lineStarts = append(s.lineStarts, i+1)
+		}
+	}
+	sc := positionScanner{data: data, positions: s}
+	sc.value(nil)
+	return s
+}
+
+// lookup returns position of value at path. If the path is not found, position of its nearest ancestor
+// is returned, e.g. of a pattern describing the value. If key is given, the key is tried first.
+func (s *sourcePositions) lookup(path []interface{}, key string) Position {
+	if s == nil {
+		return Position{}
+	}
+	if key != "" {
+		if offset, ok := s.offsets[FormatPathJSONPointer(appendPath(path, key))]; ok {
+			return s.position(offset)
+		}
+	}
+	for i := len(path); i >= 0; i-- {
+		if offset, ok := s.offsets[FormatPathJSONPointer(path[:i])]; ok {
+			return s.position(offset)
+		}
+	}
+	return Position{}
+}
+
+func (s *sourcePositions) position(offset int) Position {
+	line := sort.Search(len(s.lineStarts), func(i int) bool { return s.lineStarts[i] > offset })
+	return Position{File: s.file, Line: line, Column: offset - s.lineStarts[line-1] + 1}
+}
+
+type positionScanner struct {
+	data      []byte
+	i         int
+	positions *sourcePositions
+}
+
+func (sc *positionScanner) value(path []interface{}) {
+	sc.skipSpace()
+	if sc.i >= len(sc.data) {
+		return
+	}
+	sc.positions.offsets[FormatPathJSONPointer(path)] = sc.i
+	switch sc.data[sc.i] {
+	case '{':
+		sc.i++
+		sc.skipSpace()
+		for sc.i < len(sc.data) && sc.data[sc.i] != '}' {
+			start := sc.i
+			sc.skipString()
+			var key string
+			_ = json.Unmarshal(sc.data[start:sc.i], &key)
+			sc.skipSpace()
+			sc.i++ // ':'
+			sc.value(appendPath(path, key))
+			sc.skipSeparator()
+		}
+		sc.i++
+	case '[':
+		sc.i++
+		sc.skipSpace()
+		for n := 0; sc.i < len(sc.data) && sc.data[sc.i] != ']'; n++ {
+			sc.value(appendPath(path, n))
+			sc.skipSeparator()
+		}
+		sc.i++
+	case '"':
+		sc.skipString()
+	default:
+		for sc.i < len(sc.data) && !isJSONDelimiter(sc.data[sc.i]) {
+			sc.i++
+		}
+	}
+}
+
+func (sc *positionScanner) skipSpace() {
+	for sc.i < len(sc.data) && (sc.data[sc.i] == ' ' || sc.data[sc.i] == '\t' || sc.data[sc.i] == '\n' || sc.data[sc.i] == '\r') {
+		sc.i++
+	}
+}
+
+// skipSeparator skips a comma separating members of objects or arrays together with whitespace.
+func (sc *positionScanner) skipSeparator() {
+	sc.skipSpace()
+	if sc.i < len(sc.data) && sc.data[sc.i] == ',' {
+		sc.i++
+	}
+	sc.skipSpace()
+}
+
+func (sc *positionScanner) skipString() {
+	for sc.i++; sc.i < len(sc.data) && sc.data[sc.i] != '"'; sc.i++ {
+		if sc.data[sc.i] == '\\' {
+			sc.i++
+		}
+	}
+	sc.i++
+}
+
+func isJSONDelimiter(c byte) bool {
+	switch c {
+	case ',', ']', '}', ' ', '\t', '\n', '\r':
+		return true
+	}
+	return false
+}
+
+// withPositions sets positions of errors which do not have them yet.
+// Expected positions are looked up by path relative to the root of expected JSON located at path of base length.
+func withPositions(err error, expected *sourcePositions, base int, actual *sourcePositions) error {
+	return mapErrors(err, func(e ErrGomatch) ErrGomatch {
+		if _, ok := e.err.(unionBranchError); ok {
+			return e // wrapped errors of the branch are positioned
+		}
+		expectedPath := e.Path
+		if e.expectedPath != nil {
+			expectedPath = e.expectedPath
+		}
+		if !e.ExpectedPosition.IsValid() && expected != nil && len(expectedPath) >= base {
+			e.ExpectedPosition = expected.lookup(expectedPath[base:], e.Key)
+		}
+		if !e.ProvidedPosition.IsValid() && actual != nil {
+			e.ProvidedPosition = actual.lookup(e.Path, e.Key)
+		}
+		return e
+	})
+}
+
+// withExpectedKey records that errors located under an actual key at index i of their paths
+// belong to expected key k, which differs from the actual key when keys are compared by CompareKeys.
+func withExpectedKey(err error, i int, k string) error {
+	return mapErrors(err, func(e ErrGomatch) ErrGomatch {
+		if e.expectedPath == nil {
+			e.expectedPath = slices.Clone(e.Path)
+		}
+		if i < len(e.expectedPath) {
+			e.expectedPath[i] = k
+		}
+		return e
+	})
+}
@@ -0,0 +1,120 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositionString(t *testing.T) {
+	assert.Equal(t, "3:7", Position{Line: 3, Column: 7}.String())
+	assert.Equal(t, "user.json:3:7", Position{File: "user.json", Line: 3, Column: 7}.String())
+	assert.False(t, Position{}.IsValid())
+	assert.True(t, Position{Line: 1, Column: 1}.IsValid())
+}
+
+func TestScanPositions(t *testing.T) {
+	data := "{\n  \"a\": [1, {\"b\\\"c\": true}],\n  \"d\": \"x,}\"\n}"
+	s := scanPositions([]byte(data), "f.json")
+
+	tests := []struct {
+		desc string
+		path []interface{}
+		key  string
+		pos  Position
+	}{
+		{"root", []interface{}{}, "", Position{"f.json", 1, 1}},
+		{"array", []interface{}{"a"}, "", Position{"f.json", 2, 8}},
+		{"array item", []interface{}{"a", 0}, "", Position{"f.json", 2, 9}},
+		{"escaped key", []interface{}{"a", 1, `b"c`}, "", Position{"f.json", 2, 21}},
+		{"string with delimiters", []interface{}{"d"}, "", Position{"f.json", 3, 8}},
+		{"key", []interface{}{}, "d", Position{"f.json", 3, 8}},
+		{"missing key falls back to parent", []interface{}{"a", 1}, "e", Position{"f.json", 2, 12}},
+		{"nearest ancestor", []interface{}{"d", "e", 0}, "", Position{"f.json", 3, 8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.pos, s.lookup(tt.path, tt.key))
+		})
+	}
+}
+
+func TestJSONMatcherPositions(t *testing.T) {
+	p := `{
+  "id": "@number@",
+  "items": [
+    {"price": 10}
+  ]
+}`
+	v := `{"id": 1,
+"items": [{"price": 11}],
+"extra": true}`
+
+	m := NewDefaultJSONMatcher()
+	r, err := m.MatchResult(p, v)
+	assert.Nil(t, err)
+	assert.Len(t, r.Mismatches, 2)
+	assert.Equal(t, Position{Line: 4, Column: 15}, r.Mismatches[0].ExpectedPosition)
+	assert.Equal(t, Position{Line: 2, Column: 21}, r.Mismatches[0].ActualPosition)
+	assert.Equal(t, Position{Line: 1, Column: 1}, r.Mismatches[1].ExpectedPosition)
+	assert.Equal(t, Position{Line: 3, Column: 10}, r.Mismatches[1].ActualPosition)
+
+	_, err = m.Match(p, v)
+	assert.Contains(t, err.Error(), `4:15: values are not equal at ".items[0].price". expected: 10, provided: 11 (provided at 2:21)`)
+	assert.Contains(t, err.Error(), `1:1: unexpected key "extra" at "."`)
+
+	m.Filenames("expected.json", "actual.json")
+	_, err = m.Match(p, v)
+	assert.Contains(t, err.Error(), `expected.json:4:15: values are not equal at ".items[0].price". expected: 10, provided: 11 (provided at actual.json:2:21)`)
+	assert.Contains(t, err.Error(), `expected.json:1:1: unexpected key "extra" at "."`)
+
+	var e ErrGomatch
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, Position{"expected.json", 4, 15}, e.ExpectedPosition)
+}
+
+func TestJSONMatcherPositionsInIncludes(t *testing.T) {
+	fsys := fstest.MapFS{
+		"user.json": {Data: []byte("{\n  \"name\": \"@string@\"\n}")},
+	}
+	m := NewDefaultJSONMatcher()
+	m.IncludeFS(fsys)
+	m.Filenames("order.json", "")
+
+	_, err := m.Match(`{"total": 1, "user": "@include('user.json')@"}`, `{"total": 2, "user": {"name": 1}}`)
+	assert.Contains(t, err.Error(), `user.json:2:11: expected string at ".user.name" (included from user.json)`)
+	assert.Contains(t, err.Error(), `order.json:1:11: values are not equal at ".total"`)
+}
+
+func TestJSONMatcherPositionsWithKeyMode(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	m.CompareKeys(IgnoreCase)
+
+	r, err := m.MatchResult("{\"User\": {\n  \"Name\": \"x\"\n}}", `{"user": {"name": "y"}}`)
+	assert.Nil(t, err)
+	assert.Len(t, r.Mismatches, 1)
+	assert.Equal(t, []interface{}{"user", "name"}, r.Mismatches[0].Path)
+	assert.Equal(t, Position{Line: 2, Column: 11}, r.Mismatches[0].ExpectedPosition)
+	assert.Equal(t, Position{Line: 1, Column: 19}, r.Mismatches[0].ActualPosition)
+}
+
+func TestJSONMatcherScansPositionsOfMismatches(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	m.IncludeFS(fstest.MapFS{"a.json": {Data: []byte(`{"a": "@number@"}`)}})
+	p := []byte(`["@include('a.json')@"]`)
+
+	s := &matchState{}
+	expected, actual, err := m.decode(s, p, []byte(`[{"a": 1}]`), nil)
+	assert.Nil(t, err)
+	assert.Nil(t, m.matchDecoded(s, expected, actual, nil))
+	assert.Nil(t, s.included["a.json"].positions)
+
+	s = &matchState{}
+	expected, actual, _ = m.decode(s, p, []byte(`[{"a": "x"}]`), nil)
+	err = m.matchDecoded(s, expected, actual, nil)
+	assert.Contains(t, err.Error(), `a.json:1:7: expected number at ".[0].a"`)
+	assert.NotNil(t, s.included["a.json"].positions)
+}
@@ -0,0 +1,220 @@
+package gomatch
+
+import (
+	"errors"
+	"reflect"
+)
+
+// A MismatchKind classifies a Mismatch.
+type MismatchKind string
+
+const (
+	KindTypeMismatch    MismatchKind = "type_mismatch"
+	KindValueMismatch   MismatchKind = "value_mismatch"
+	KindPatternMismatch MismatchKind = "pattern_mismatch"
+	KindMissingKey      MismatchKind = "missing_key"
+	KindUnexpectedKey   MismatchKind = "unexpected_key"
+	KindRenamedKey      MismatchKind = "renamed_key"
+	KindAmbiguousKey    MismatchKind = "ambiguous_key"
+	KindUnknownPattern  MismatchKind = "unknown_pattern"
+	KindArrayLength     MismatchKind = "array_length"
+	KindAssertion       MismatchKind = "assertion"
+	KindUnion           MismatchKind = "union"
+	KindFragment        MismatchKind = "fragment"
+	KindInclude         MismatchKind = "include"
+	KindUnresolvedParam MismatchKind = "unresolved_param"
+	KindInvalidPattern  MismatchKind = "invalid_pattern"
+	KindUnknownMismatch MismatchKind = "unknown"
+)
+
+// A Mismatch describes a single difference between expected and actual JSON.
+type Mismatch struct {
+	// Path is a path of the value in the actual JSON, for missing and unexpected keys it includes the key.
+	Path []interface{}
+
+	Kind     MismatchKind
+	Expected interface{}
+	Actual   interface{}
+
+	// Matcher is a type name of the ValueMatcher which handled Pattern, e.g. "StringMatcher".
+	Matcher string
+
+	// Pattern is the expected value if it is a pattern.
+	Pattern string
+
+	// Source is a file the expected value was included from.
+	Source string
+
+	// ExpectedPosition and ActualPosition locate the values in the expected and actual JSON.
+	ExpectedPosition Position
+	ActualPosition   Position
+
+	// Err is the error describing the mismatch.
+	Err error
+}
+
+// Stats counts parts of the expected JSON visited while matching.
+type Stats struct {
+	Objects  int
+	Arrays   int
+	Values   int
+	Patterns int
+}
+
+// A Result is a structured result of MatchResult.
+type Result struct {
+	Matched    bool
+	Mismatches []Mismatch
+
+	// Captures are actual values matched by patterns, keyed by their path, e.g. ".user.id".
+	// Paths are formatted by the format set with FormatPaths.
+	Captures map[string]interface{}
+
+	Stats Stats
+
+	// Truncated is true if mismatches over the limit set by MaxErrors or FailFast were dropped,
+	// there are more mismatches than reported.
+	Truncated bool
+
+	err              error
+	expected, actual interface{}
+	pathFormat       PathFormat
+	keyMode          StringMode
+}
+
+// Err returns the error which Match would return, nil if JSONs match.
+func (r *Result) Err() error {
+	return r.err
+}
+
+// MatchResult performs deep match like Match, but it returns mismatches as Mismatch records
+// together with captured values and statistics.
+//
+// An error is returned only if expected or actual JSON is invalid.
+func (m *JSONMatcher) MatchResult(expectedJSON, actualJSON string) (*Result, error) {
+	s := &matchState{captures: map[string]interface{}{}}
+	expected, actual, err := m.decode(s, []byte(expectedJSON), []byte(actualJSON), nil)
+	if err != nil {
+		return nil, err
+	}
+	err = m.matchDecoded(s, expected, actual, nil)
+	r := &Result{
+		Matched:    err == nil,
+		Mismatches: []Mismatch{},
+		Captures:   s.captures,
+		Stats:      s.stats,
+		Truncated:  s.truncated,
+		err:        err,
+		expected:   expected,
+		actual:     actual,
+		pathFormat: m.pathFormat,
+		keyMode:    m.keyMode,
+	}
+	for _, e := range flattenErrors(err) {
+		r.Mismatches = append(r.Mismatches, m.mismatch(e))
+	}
+	if err != nil && len(r.Mismatches) == 0 {
+		r.Mismatches = append(r.Mismatches, Mismatch{Kind: KindUnknownMismatch, Err: err})
+	}
+	return r, nil
+}
+
+func (m *JSONMatcher) mismatch(e ErrGomatch) Mismatch {
+	r := Mismatch{
+		Path:     e.Path,
+		Kind:     mismatchKind(e.err),
+		Expected: e.Expected,
+		Actual:   e.Provided,
+		Source:   e.Source,
+		Err:      e.err,
+
+		ExpectedPosition: e.ExpectedPosition,
+		ActualPosition:   e.ProvidedPosition,
+	}
+	if r.Kind == KindMissingKey || r.Kind == KindUnexpectedKey || r.Kind == KindRenamedKey || r.Kind == KindAmbiguousKey {
+		r.Path = appendPath(e.Path, e.Key)
+	}
+	if p, ok := e.Expected.(string); ok && m.valueMatcher.CanMatch(p) {
+		r.Pattern = p
+		r.Matcher = matcherName(m.valueMatcher, p)
+	}
+	return r
+}
+
+func mismatchKind(err error) MismatchKind {
+	kinds := []struct {
+		kind MismatchKind
+		errs []error
+	}{
+		{KindInvalidPattern, []error{ErrInvalidPattern, errInvalidJSONPattern}},
+		{KindUnresolvedParam, []error{ErrUnresolvedParam}},
+		{KindMissingKey, []error{ErrMissingKey}},
+		{KindUnexpectedKey, []error{ErrUnexpectedKey}},
+		{KindRenamedKey, []error{ErrRenamedKey}},
+		{KindAmbiguousKey, []error{ErrAmbiguousKey}},
+		{KindUnknownPattern, []error{ErrUnknownPattern}},
+		{KindTypeMismatch, []error{ErrTypesNotEqual}},
+		{KindArrayLength, []error{errArraysLenNotEqual}},
+		{KindValueMismatch, []error{errValuesNotEqual}},
+		{KindAssertion, []error{ErrAssertionFailed}},
+		{KindUnion, []error{ErrUnionDiscriminator, ErrUnionUnknownBranch}},
+		{KindFragment, []error{ErrUnknownFragment, ErrFragmentCycle}},
+		{KindInclude, []error{ErrInclude, ErrIncludeCycle}},
+	}
+	for _, k := range kinds {
+		for _, e := range k.errs {
+			if errors.Is(err, e) {
+				return k.kind
+			}
+		}
+	}
+	return KindPatternMismatch
+}
+
+// matcherName returns type name of the value matcher handling pattern p, chains are searched through.
+func matcherName(vm ValueMatcher, p interface{}) string {
+	for {
+		chain, ok := vm.(*ChainMatcher)
+		if !ok {
+			break
+		}
+		found := false
+		for _, m := range chain.matchers {
+			if m.CanMatch(p) {
+				vm, found = m, true
+				break
+			}
+		}
+		if !found {
+			return ""
+		}
+	}
+	t := reflect.TypeOf(vm)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// flattenErrors returns leaf ErrGomatch errors of err.
+// Errors wrapping other ErrGomatch errors, e.g. of union branches, are replaced with the wrapped errors.
+func flattenErrors(err error) []ErrGomatch {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case ErrGomatch:
+		if inner := flattenErrors(e.err); len(inner) > 0 {
+			return inner
+		}
+		return []ErrGomatch{e}
+	case interface{ Unwrap() []error }:
+		errs := []ErrGomatch{}
+		for _, err := range e.Unwrap() {
+			errs = append(errs, flattenErrors(err)...)
+		}
+		return errs
+	case interface{ Unwrap() error }:
+		return flattenErrors(e.Unwrap())
+	}
+	return nil
+}
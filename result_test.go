@@ -0,0 +1,100 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONMatcherMatchResult(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+
+	r, err := m.MatchResult(
+		`{"id": "@number@", "name": "John", "tags": ["@string@", "@...@"]}`,
+		`{"id": 351, "name": "John", "tags": ["a", "b"]}`,
+	)
+	assert.Nil(t, err)
+	assert.True(t, r.Matched)
+	assert.Nil(t, r.Err())
+	assert.Empty(t, r.Mismatches)
+	assert.Equal(t, map[string]interface{}{".id": 351.0, ".tags[0]": "a"}, r.Captures)
+	assert.Equal(t, Stats{Objects: 1, Arrays: 1, Values: 3, Patterns: 2}, r.Stats)
+}
+
+func TestJSONMatcherMatchResultMismatches(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+
+	r, err := m.MatchResult(
+		`{"id": "@number@", "name": "John", "items": [1, 2], "address": {"city": "@string@"}, "email": "@email@", "age": 1}`,
+		`{"id": "351", "name": "Joe", "items": [1], "address": {"city": "Boston"}, "email": "john@example.com", "phone": "1", "age": "1"}`,
+	)
+	assert.Nil(t, err)
+	assert.False(t, r.Matched)
+	assert.NotNil(t, r.Err())
+	assert.Equal(t, map[string]interface{}{".address.city": "Boston", ".email": "john@example.com"}, r.Captures)
+
+	expected := map[string]Mismatch{
+		".id": {
+			Path: []interface{}{"id"}, Kind: KindPatternMismatch, Expected: "@number@", Actual: "351",
+			Matcher: "NumberMatcher", Pattern: "@number@", Err: errNotNumber,
+		},
+		".name": {
+			Path: []interface{}{"name"}, Kind: KindValueMismatch, Expected: "John", Actual: "Joe", Err: errValuesNotEqual,
+		},
+		".items": {
+			Path: []interface{}{"items"}, Kind: KindArrayLength, Expected: []interface{}{1.0, 2.0}, Actual: []interface{}{1.0}, Err: errArraysLenNotEqual,
+		},
+		".phone": {
+			Path: []interface{}{"phone"}, Kind: KindUnexpectedKey, Actual: "1", Err: ErrUnexpectedKey,
+		},
+		".age": {
+			Path: []interface{}{"age"}, Kind: KindTypeMismatch, Expected: 1.0, Actual: "1", Err: ErrTypesNotEqual,
+		},
+	}
+	assert.Len(t, r.Mismatches, len(expected))
+	for _, mm := range r.Mismatches {
+		want, ok := expected[pathToString(mm.Path)]
+		if !assert.True(t, ok, "unexpected mismatch at %s", pathToString(mm.Path)) {
+			continue
+		}
+		assert.True(t, errors.Is(mm.Err, want.Err), "unexpected error %v", mm.Err)
+		assert.True(t, mm.ExpectedPosition.IsValid() && mm.ActualPosition.IsValid())
+		mm.Err, want.Err = nil, nil
+		mm.ExpectedPosition, mm.ActualPosition = Position{}, Position{}
+		assert.Equal(t, want, mm)
+	}
+}
+
+func TestJSONMatcherMatchResultKinds(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	tests := []struct {
+		desc string
+		p    string
+		v    string
+		kind MismatchKind
+	}{
+		{"missing key", `{"a": 1}`, `{}`, KindMissingKey},
+		{"invalid pattern", `{"a": "@ip@.inSubnet("}`, `{"a": "10.0.0.1"}`, KindInvalidPattern},
+		{"assertion", `{"a": 1, "@assert@": "a > 1"}`, `{"a": 1}`, KindAssertion},
+		{"union branch", `"@union('type', {\"a\": {\"x\": 1}})@"`, `{"type": "a", "x": 2}`, KindValueMismatch},
+		{"unknown union branch", `"@union('type', {\"a\": {}})@"`, `{"type": "b"}`, KindUnion},
+		{"fragment", `"@ref(user)@"`, `{}`, KindFragment},
+		{"include", `"@include('missing.json')@"`, `{}`, KindInclude},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			r, err := m.MatchResult(tt.p, tt.v)
+			assert.Nil(t, err)
+			assert.False(t, r.Matched)
+			if assert.Len(t, r.Mismatches, 1) {
+				assert.Equal(t, tt.kind, r.Mismatches[0].Kind)
+			}
+		})
+	}
+
+	r, err := m.MatchResult(`{"a": 1}`, `{"a": 1`)
+	assert.Nil(t, r)
+	assert.True(t, errors.Is(err, errInvalidJSON))
+}
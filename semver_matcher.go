@@ -0,0 +1,394 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var semverRe = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+var (
+	ErrNotSemver        = errors.New("expected semantic version")
+	ErrSemverNotInRange = errors.New("expected semantic version satisfying")
+)
+
+// A SemverMatcher matches semantic versions as defined by https://semver.org.
+//
+// It supports optional constraints:
+//
+//	"@semver@.satisfies('>=1.4.0 <2.0.0')"  - version satisfying a range
+//	"@semver@.includePrerelease()"          - pre-release versions may satisfy ranges
+//	"@semver@.stable()"                     - version without pre-release
+//
+// A range is a set of comparators separated by spaces, all of them must be satisfied.
+// Sets can be combined with "||". Supported comparators are "=", "!=", ">", ">=", "<", "<=",
+// tilde ("~1.2.3" - patch updates), caret ("^1.2.3" - compatible updates),
+// x-ranges ("1.x", "1.2.*") and hyphen ranges ("1.2.3 - 2.3.4").
+//
+// Like in npm, a pre-release version satisfies a range only if some comparator of the set
+// has a pre-release on the same major.minor.patch tuple, unless includePrerelease() is used.
+type SemverMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *SemverMatcher) CanMatch(p interface{}) bool {
+	return hasPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *SemverMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
+	s, ok := v.(string)
+	if !ok {
+		return false, ErrNotSemver
+	}
+	ver, ok := parseSemver(s)
+	if !ok {
+		return false, ErrNotSemver
+	}
+	includePrerelease := false
+	ranges := []semverRange{}
+	for _, c := range calls {
+		switch c.name {
+		case "satisfies":
+			if len(c.args) != 1 {
+				return false, errConstraintArgs(c, 1)
+			}
+			r, err := parseSemverRange(c.args[0])
+			if err != nil {
+				return false, fmt.Errorf("%w: %s", ErrInvalidPattern, err)
+			}
+			ranges = append(ranges, r)
+		case "includePrerelease":
+			if len(c.args) != 0 {
+				return false, errConstraintArgs(c, 0)
+			}
+			includePrerelease = true
+		case "stable":
+			if len(c.args) != 0 {
+				return false, errConstraintArgs(c, 0)
+			}
+			if ver.pre != nil {
+				return false, fmt.Errorf("%w without pre-release", ErrNotSemver)
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
+	for _, r := range ranges {
+		if !r.contains(ver, includePrerelease) {
+			return false, fmt.Errorf("%w %q", ErrSemverNotInRange, r.raw)
+		}
+	}
+	return true, nil
+}
+
+// ValidatePattern validates constraints of pattern p.
+func (m *SemverMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		if c.name != "satisfies" {
+			return validateFlagCall(c, "includePrerelease", "stable")
+		}
+		if len(c.args) != 1 {
+			return errConstraintArgs(c, 1)
+		}
+		if _, err := parseSemverRange(c.args[0]); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidPattern, err)
+		}
+		return nil
+	})
+}
+
+// NewSemverMatcher creates SemverMatcher.
+func NewSemverMatcher(pattern string) *SemverMatcher {
+	return &SemverMatcher{pattern}
+}
+
+type semver struct {
+	major, minor, patch uint64
+	pre                 []string
+}
+
+func parseSemver(s string) (semver, bool) {
+	parts := semverRe.FindStringSubmatch(s)
+	if parts == nil {
+		return semver{}, false
+	}
+	v := semver{}
+	var err error
+	if v.major, err = strconv.ParseUint(parts[1], 10, 64); err != nil {
+		return semver{}, false
+	}
+	if v.minor, err = strconv.ParseUint(parts[2], 10, 64); err != nil {
+		return semver{}, false
+	}
+	if v.patch, err = strconv.ParseUint(parts[3], 10, 64); err != nil {
+		return semver{}, false
+	}
+	if parts[4] != "" {
+		v.pre = strings.Split(parts[4], ".")
+	}
+	return v, true
+}
+
+// compare returns -1, 0 or 1 following the semver precedence rules. Build metadata is ignored.
+func (v semver) compare(o semver) int {
+	for _, d := range [][2]uint64{{v.major, o.major}, {v.minor, o.minor}, {v.patch, o.patch}} {
+		if d[0] != d[1] {
+			if d[0] < d[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+	switch {
+	case v.pre == nil && o.pre == nil:
+		return 0
+	case v.pre == nil:
+		return 1
+	case o.pre == nil:
+		return -1
+	}
+	for i := 0; i < len(v.pre) && i < len(o.pre); i++ {
+		if c := comparePrerelease(v.pre[i], o.pre[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(v.pre) < len(o.pre):
+		return -1
+	case len(v.pre) > len(o.pre):
+		return 1
+	}
+	return 0
+}
+
+func comparePrerelease(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		if an == bn {
+			return 0
+		}
+		if an < bn {
+			return -1
+		}
+		return 1
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func (v semver) sameTuple(o semver) bool {
+	return v.major == o.major && v.minor == o.minor && v.patch == o.patch
+}
+
+type semverComparator struct {
+	op  string
+	ver semver
+}
+
+func (c semverComparator) test(v semver) bool {
+	r := v.compare(c.ver)
+	switch c.op {
+	case ">":
+		return r > 0
+	case ">=":
+		return r >= 0
+	case "<":
+		return r < 0
+	case "<=":
+		return r <= 0
+	case "!=":
+		return r != 0
+	}
+	return r == 0
+}
+
+type semverRange struct {
+	raw  string
+	sets [][]semverComparator
+}
+
+func (r semverRange) contains(v semver, includePrerelease bool) bool {
+	for _, set := range r.sets {
+		if semverSetContains(set, v, includePrerelease) {
+			return true
+		}
+	}
+	return false
+}
+
+func semverSetContains(set []semverComparator, v semver, includePrerelease bool) bool {
+	for _, c := range set {
+		if !c.test(v) {
+			return false
+		}
+	}
+	if v.pre == nil || includePrerelease {
+		return true
+	}
+	for _, c := range set {
+		if c.ver.pre != nil && c.ver.sameTuple(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func parseSemverRange(s string) (semverRange, error) {
+	r := semverRange{raw: s}
+	for _, alt := range strings.Split(s, "||") {
+		set := []semverComparator{}
+		fields := joinSemverOperators(strings.Fields(alt))
+		if len(fields) == 0 {
+			return semverRange{}, fmt.Errorf("invalid version range %q", s)
+		}
+		if len(fields) == 3 && fields[1] == "-" {
+			lower, err := parseSemverComparator(">=" + fields[0])
+			if err != nil {
+				return semverRange{}, err
+			}
+			upper, err := parseSemverComparator("<=" + fields[2])
+			if err != nil {
+				return semverRange{}, err
+			}
+			fields = nil
+			set = append(lower, upper...)
+		}
+		for _, f := range fields {
+			comparators, err := parseSemverComparator(f)
+			if err != nil {
+				return semverRange{}, err
+			}
+			set = append(set, comparators...)
+		}
+		r.sets = append(r.sets, set)
+	}
+	return r, nil
+}
+
+// joinSemverOperators joins operators separated from their versions by spaces, e.g. ">= 1.2.3", to the versions.
+func joinSemverOperators(fields []string) []string {
+	joined := []string{}
+	for i := 0; i < len(fields); i++ {
+		if slices.Contains(semverOperators, fields[i]) && i+1 < len(fields) {
+			joined = append(joined, fields[i]+fields[i+1])
+			i++
+			continue
+		}
+		joined = append(joined, fields[i])
+	}
+	return joined
+}
+
+// semverOperators are operators of comparators, longer ones first.
+var semverOperators = []string{">=", "<=", "!=", ">", "<", "=", "~", "^"}
+
+// parseSemverComparator expands a single comparator, including tilde, caret and x-ranges,
+// into primitive comparators.
+func parseSemverComparator(s string) ([]semverComparator, error) {
+	op := ""
+	for _, o := range semverOperators {
+		if strings.HasPrefix(s, o) {
+			op = o
+			break
+		}
+	}
+	raw := strings.TrimPrefix(strings.TrimPrefix(s, op), "v")
+	ver, wild, err := parsePartialSemver(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version range %q", s)
+	}
+	lower := semverComparator{">=", ver}
+	switch {
+	case op == "~":
+		if wild == 1 || wild == 2 {
+			return []semverComparator{lower, {"<", prereleaseBound(ver.major+1, 0, 0)}}, nil
+		}
+		return []semverComparator{lower, {"<", prereleaseBound(ver.major, ver.minor+1, 0)}}, nil
+	case op == "^":
+		switch {
+		case ver.major != 0 || wild == 1 || wild == 2:
+			return []semverComparator{lower, {"<", prereleaseBound(ver.major+1, 0, 0)}}, nil
+		case ver.minor != 0 || wild == 3:
+			return []semverComparator{lower, {"<", prereleaseBound(0, ver.minor+1, 0)}}, nil
+		}
+		return []semverComparator{lower, {"<", prereleaseBound(0, 0, ver.patch+1)}}, nil
+	case wild == 0:
+		if op == "" {
+			op = "="
+		}
+		return []semverComparator{{op, ver}}, nil
+	}
+	upper := prereleaseBound(ver.major+1, 0, 0)
+	if wild == 3 {
+		upper = prereleaseBound(ver.major, ver.minor+1, 0)
+	}
+	switch op {
+	case "", "=":
+		if wild == 1 {
+			return []semverComparator{{">=", semver{}}}, nil
+		}
+		return []semverComparator{lower, {"<", upper}}, nil
+	case ">":
+		upper.pre = nil
+		return []semverComparator{{">=", upper}}, nil
+	case ">=":
+		return []semverComparator{lower}, nil
+	case "<":
+		return []semverComparator{{"<", prereleaseBound(ver.major, ver.minor, 0)}}, nil
+	case "<=":
+		return []semverComparator{{"<", upper}}, nil
+	}
+	return nil, fmt.Errorf("invalid version range %q", s)
+}
+
+// prereleaseBound returns the lowest pre-release of a version, e.g. 2.0.0-0. Ranges ending before
+// the bound exclude pre-releases of the version, so "^1.2.3" does not match "2.0.0-alpha".
+func prereleaseBound(major, minor, patch uint64) semver {
+	return semver{major: major, minor: minor, patch: patch, pre: []string{"0"}}
+}
+
+// parsePartialSemver parses a version which may omit or wildcard its trailing parts.
+// It returns the number of the first wildcard part (1 - major, 2 - minor, 3 - patch) or 0 for full versions.
+func parsePartialSemver(s string) (semver, int, error) {
+	if v, ok := parseSemver(s); ok {
+		return v, 0, nil
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return semver{}, 0, ErrNotSemver
+	}
+	nums := [3]uint64{}
+	for i := 0; i < 3; i++ {
+		if i >= len(parts) || parts[i] == "x" || parts[i] == "X" || parts[i] == "*" {
+			for j := i + 1; j < len(parts); j++ {
+				if parts[j] != "x" && parts[j] != "X" && parts[j] != "*" {
+					return semver{}, 0, ErrNotSemver
+				}
+			}
+			return semver{major: nums[0], minor: nums[1]}, i + 1, nil
+		}
+		n, err := strconv.ParseUint(parts[i], 10, 64)
+		if err != nil {
+			return semver{}, 0, err
+		}
+		nums[i] = n
+	}
+	return semver{}, 0, ErrNotSemver
+}
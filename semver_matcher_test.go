@@ -0,0 +1,308 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var semverMatcherTests = []struct {
+	desc string
+	p    string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match version",
+		"@pattern@",
+		"1.4.2",
+		true,
+		nil,
+	},
+	{
+		"Should match version with pre-release and build",
+		"@pattern@",
+		"1.0.0-alpha.1+build.5",
+		true,
+		nil,
+	},
+	{
+		"Should not match version with leading zero",
+		"@pattern@",
+		"1.04.2",
+		false,
+		ErrNotSemver,
+	},
+	{
+		"Should not match partial version",
+		"@pattern@",
+		"1.4",
+		false,
+		ErrNotSemver,
+	},
+	{
+		"Should not match number",
+		"@pattern@",
+		1.4,
+		false,
+		ErrNotSemver,
+	},
+	{
+		"Should match version in range",
+		"@pattern@.satisfies('>=1.4.0 <2.0.0')",
+		"1.9.3",
+		true,
+		nil,
+	},
+	{
+		"Should not match version above range",
+		"@pattern@.satisfies('>=1.4.0 <2.0.0')",
+		"2.0.0",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should not match version below range",
+		"@pattern@.satisfies('>=1.4.0 <2.0.0')",
+		"1.3.9",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should match any of alternative ranges",
+		"@pattern@.satisfies('^1.2.0 || ^3.0.0')",
+		"3.1.0",
+		true,
+		nil,
+	},
+	{
+		"Should match caret range",
+		"@pattern@.satisfies('^1.2.3')",
+		"1.9.0",
+		true,
+		nil,
+	},
+	{
+		"Should not match caret range on major update",
+		"@pattern@.satisfies('^1.2.3')",
+		"2.0.0",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should not match caret range of zero major on minor update",
+		"@pattern@.satisfies('^0.2.3')",
+		"0.3.0",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should match tilde range",
+		"@pattern@.satisfies('~1.2.3')",
+		"1.2.9",
+		true,
+		nil,
+	},
+	{
+		"Should not match tilde range on minor update",
+		"@pattern@.satisfies('~1.2.3')",
+		"1.3.0",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should match x-range",
+		"@pattern@.satisfies('1.x')",
+		"1.99.0",
+		true,
+		nil,
+	},
+	{
+		"Should not match x-range",
+		"@pattern@.satisfies('1.2.x')",
+		"1.3.0",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should match hyphen range",
+		"@pattern@.satisfies('1.2.3 - 2.3')",
+		"2.3.9",
+		true,
+		nil,
+	},
+	{
+		"Should not match hyphen range",
+		"@pattern@.satisfies('1.2.3 - 2.3')",
+		"2.4.0",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should match comparators with operators separated by spaces",
+		"@pattern@.satisfies('>= 1.2.3 < 2.0.0 || ^ 3.1.0')",
+		"1.9.0",
+		true,
+		nil,
+	},
+	{
+		"Should not match comparators with operators separated by spaces",
+		"@pattern@.satisfies('>= 1.2.3 < 2.0.0')",
+		"2.0.0",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should fail on operator without version",
+		"@pattern@.satisfies('>=1.2.3 <')",
+		"1.5.0",
+		false,
+		ErrInvalidPattern,
+	},
+	{
+		"Should not match pre-release by range without pre-release",
+		"@pattern@.satisfies('>=1.0.0')",
+		"1.5.0-beta.1",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should match pre-release by range with pre-release on the same version",
+		"@pattern@.satisfies('>=1.5.0-alpha <2.0.0')",
+		"1.5.0-beta.1",
+		true,
+		nil,
+	},
+	{
+		"Should match pre-release by range when pre-releases included",
+		"@pattern@.satisfies('>=1.0.0').includePrerelease()",
+		"1.5.0-beta.1",
+		true,
+		nil,
+	},
+	{
+		"Should match pre-release inside of caret range when pre-releases included",
+		"@pattern@.satisfies('^1.2.3').includePrerelease()",
+		"1.9.0-beta",
+		true,
+		nil,
+	},
+	{
+		"Should not match pre-release of caret upper bound",
+		"@pattern@.satisfies('^1.2.3').includePrerelease()",
+		"2.0.0-alpha",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should not match lowest pre-release of caret upper bound",
+		"@pattern@.satisfies('^1.2.3').includePrerelease()",
+		"2.0.0-0",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should not match pre-release of caret upper bound of zero major",
+		"@pattern@.satisfies('^0.2.3').includePrerelease()",
+		"0.3.0-rc.1",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should not match pre-release of caret upper bound of zero minor",
+		"@pattern@.satisfies('^0.0.3').includePrerelease()",
+		"0.0.4-rc.1",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should not match pre-release of tilde upper bound",
+		"@pattern@.satisfies('~1.2.3').includePrerelease()",
+		"1.3.0-alpha",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should not match pre-release of tilde major upper bound",
+		"@pattern@.satisfies('~1').includePrerelease()",
+		"2.0.0-alpha",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should not match pre-release of x-range upper bound",
+		"@pattern@.satisfies('1.2.x').includePrerelease()",
+		"1.3.0-alpha",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should not match pre-release of less than x-range bound",
+		"@pattern@.satisfies('<1.x').includePrerelease()",
+		"1.0.0-alpha",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should not match pre-release of partial hyphen range upper bound",
+		"@pattern@.satisfies('1.2.3 - 2.3').includePrerelease()",
+		"2.4.0-alpha",
+		false,
+		ErrSemverNotInRange,
+	},
+	{
+		"Should fail on includePrerelease constraint with arguments",
+		"@pattern@.includePrerelease(true)",
+		"1.0.0",
+		false,
+		ErrInvalidPattern,
+	},
+	{
+		"Should fail on stable constraint with arguments",
+		"@pattern@.stable('yes')",
+		"1.0.0",
+		false,
+		ErrInvalidPattern,
+	},
+	{
+		"Should order pre-releases numerically",
+		"@pattern@.satisfies('>1.0.0-alpha.2 <1.0.0')",
+		"1.0.0-alpha.10",
+		true,
+		nil,
+	},
+	{
+		"Should not match pre-release when stable version expected",
+		"@pattern@.stable()",
+		"1.0.0-rc.1",
+		false,
+		ErrNotSemver,
+	},
+	{
+		"Should fail on invalid range",
+		"@pattern@.satisfies('>=one')",
+		"1.0.0",
+		false,
+		ErrInvalidPattern,
+	},
+}
+
+func TestSemverMatcher(t *testing.T) {
+	for _, tt := range semverMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewSemverMatcher("@pattern@")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
+
+			ok, err := m.Match(tt.p, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
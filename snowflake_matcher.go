@@ -0,0 +1,42 @@
+package gomatch
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+var ErrNotSnowflake = errors.New("expected snowflake ID")
+
+// A SnowflakeMatcher matches snowflake IDs, positive 64-bit integers.
+// Snowflakes usually exceed the precision of JSON numbers so they are accepted
+// both as decimal strings and as numbers.
+type SnowflakeMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *SnowflakeMatcher) CanMatch(p interface{}) bool {
+	return isPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *SnowflakeMatcher) Match(p, v interface{}) (bool, error) {
+	switch a := v.(type) {
+	case string:
+		n, err := strconv.ParseInt(a, 10, 64)
+		if err == nil && n > 0 && a[0] != '+' {
+			return true, nil
+		}
+	case float64:
+		if a > 0 && a <= math.MaxInt64 && a == math.Trunc(a) {
+			return true, nil
+		}
+	}
+	return false, ErrNotSnowflake
+}
+
+// NewSnowflakeMatcher creates SnowflakeMatcher.
+func NewSnowflakeMatcher(pattern string) *SnowflakeMatcher {
+	return &SnowflakeMatcher{pattern}
+}
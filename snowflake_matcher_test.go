@@ -0,0 +1,72 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var snowflakeMatcherTests = []struct {
+	desc string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match snowflake string",
+		"175928847299117063",
+		true,
+		nil,
+	},
+	{
+		"Should match snowflake number",
+		1541815603606036480.,
+		true,
+		nil,
+	},
+	{
+		"Should not match negative snowflake",
+		"-175928847299117063",
+		false,
+		ErrNotSnowflake,
+	},
+	{
+		"Should not match overflowing snowflake",
+		"18446744073709551616",
+		false,
+		ErrNotSnowflake,
+	},
+	{
+		"Should not match fraction",
+		1.5,
+		false,
+		ErrNotSnowflake,
+	},
+	{
+		"Should not match non numeric string",
+		"abc",
+		false,
+		ErrNotSnowflake,
+	},
+}
+
+func TestSnowflakeMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range snowflakeMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewSnowflakeMatcher(pattern)
+			assert.True(t, m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package gomatch
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrAmbiguousKey is reported when more than one key of actual object equals an expected key in the mode set by CompareKeys.
+var ErrAmbiguousKey = errors.New("ambiguous key")
+
+// A StringMode defines how strings are compared. Modes may be combined, e.g. IgnoreCase|IgnoreWhitespace.
+type StringMode int
+
+const (
+	// IgnoreCase compares strings using Unicode case folding.
+	IgnoreCase StringMode = 1 << iota
+
+	// IgnoreWhitespace trims leading and trailing whitespace and collapses inner whitespace to a single space.
+	IgnoreWhitespace
+
+	// NormalizeUnicode compares strings in Unicode normalization form C.
+	NormalizeUnicode
+)
+
+// normalize returns s transformed according to the mode.
+func (mode StringMode) normalize(s string) string {
+	if mode&NormalizeUnicode != 0 {
+		s = norm.NFC.String(s)
+	}
+	if mode&IgnoreWhitespace != 0 {
+		s = strings.Join(strings.Fields(s), " ")
+	}
+	if mode&IgnoreCase != 0 {
+		s = cases.Fold().String(s)
+	}
+	return s
+}
+
+// equal returns true if strings are equal in the mode.
+func (mode StringMode) equal(a, b string) bool {
+	return a == b || mode.normalize(a) == mode.normalize(b)
+}
+
+// CompareStrings sets mode used to compare string values of expected JSON with actual values.
+// It does not affect patterns.
+func (m *JSONMatcher) CompareStrings(mode StringMode) {
+	m.stringMode = mode
+}
+
+// CompareKeys sets mode used to compare object keys of expected JSON with keys of actual objects.
+func (m *JSONMatcher) CompareKeys(mode StringMode) {
+	m.keyMode = mode
+}
+
+// keyPairs pairs keys of an expected object with keys of an actual object.
+type keyPairs struct {
+	// paired maps expected keys to actual keys.
+	paired map[string]string
+
+	// ambiguous maps expected keys to sorted actual keys they all match.
+	ambiguous map[string][]string
+
+	// used are actual keys paired with or matched by expected keys.
+	used map[string]bool
+}
+
+// pairKeys pairs keys of expected object with keys of actual object equal in the mode.
+// Exact matches are paired first, each actual key is paired at most once. Expected keys matching more than
+// one of the remaining actual keys are ambiguous. Actual keys are normalized once per object.
+func (mode StringMode) pairKeys(expected, actual map[string]interface{}) keyPairs {
+	pairs := keyPairs{paired: map[string]string{}, ambiguous: map[string][]string{}, used: map[string]bool{}}
+	rest := []string{}
+	for _, k := range slices.Sorted(maps.Keys(expected)) {
+		_, ok := actual[k]
+		switch {
+		case isUnbounded(k) || isAssert(k):
+			if ok {
+				pairs.used[k] = true
+			}
+		case ok:
+			pairs.paired[k], pairs.used[k] = k, true
+		default:
+			rest = append(rest, k)
+		}
+	}
+	if mode == 0 || len(rest) == 0 {
+		return pairs
+	}
+	normalized := map[string][]string{}
+	for _, ak := range slices.Sorted(maps.Keys(actual)) {
+		if !pairs.used[ak] {
+			n := mode.normalize(ak)
+			normalized[n] = append(normalized[n], ak)
+		}
+	}
+	for _, k := range rest {
+		keys := []string{}
+		for _, ak := range normalized[mode.normalize(k)] {
+			if !pairs.used[ak] {
+				keys = append(keys, ak)
+			}
+		}
+		for _, ak := range keys {
+			pairs.used[ak] = true
+		}
+		switch len(keys) {
+		case 0:
+		case 1:
+			pairs.paired[k] = keys[0]
+		default:
+			pairs.ambiguous[k] = keys
+		}
+	}
+	return pairs
+}
+
+// quoteKeys formats keys as a list of quoted strings, e.g. `"Name", "name"`.
+func quoteKeys(keys []string) string {
+	quoted := []string{}
+	for _, k := range keys {
+		quoted = append(quoted, strconv.Quote(k))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,96 @@
+package gomatch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringModeNormalize(t *testing.T) {
+	tests := []struct {
+		desc string
+		mode StringMode
+		s    string
+		want string
+	}{
+		{"exact", 0, " Ab ", " Ab "},
+		{"case", IgnoreCase, "ÀbC", "àbc"},
+		{"whitespace", IgnoreWhitespace, " a \t b\n", "a b"},
+		{"unicode", NormalizeUnicode, "e\u0301", "\u00e9"},
+		{"combined", IgnoreCase | IgnoreWhitespace | NormalizeUnicode, " CAFÉ  Bar ", "café bar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.mode.normalize(tt.s))
+		})
+	}
+}
+
+func TestStringModePairKeys(t *testing.T) {
+	tests := []struct {
+		desc      string
+		mode      StringMode
+		expected  map[string]interface{}
+		actual    map[string]interface{}
+		paired    map[string]string
+		ambiguous map[string][]string
+	}{
+		{
+			"exact",
+			0,
+			map[string]interface{}{"a": 1, "B": 1},
+			map[string]interface{}{"a": 1, "b": 1},
+			map[string]string{"a": "a"},
+			map[string][]string{},
+		},
+		{
+			"normalized",
+			IgnoreCase,
+			map[string]interface{}{"a": 1, "B": 1},
+			map[string]interface{}{"a": 1, "b": 1},
+			map[string]string{"a": "a", "B": "b"},
+			map[string][]string{},
+		},
+		{
+			"exact match preferred",
+			IgnoreCase,
+			map[string]interface{}{"name": 1},
+			map[string]interface{}{"Name": 1, "name": 1},
+			map[string]string{"name": "name"},
+			map[string][]string{},
+		},
+		{
+			"actual key paired once",
+			IgnoreCase,
+			map[string]interface{}{"Name": 1, "NAME": 1},
+			map[string]interface{}{"name": 1},
+			map[string]string{"NAME": "name"},
+			map[string][]string{},
+		},
+		{
+			"exact match taken by another key",
+			IgnoreCase,
+			map[string]interface{}{"Name": 1, "name": 1},
+			map[string]interface{}{"name": 1},
+			map[string]string{"name": "name"},
+			map[string][]string{},
+		},
+		{
+			"ambiguous",
+			IgnoreCase,
+			map[string]interface{}{"name": 1},
+			map[string]interface{}{"Name": 1, "NAME": 1},
+			map[string]string{},
+			map[string][]string{"name": {"NAME", "Name"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			pairs := tt.mode.pairKeys(tt.expected, tt.actual)
+			assert.Equal(t, tt.paired, pairs.paired)
+			assert.Equal(t, tt.ambiguous, pairs.ambiguous)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package gomatch
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrRenamedKey is reported instead of a missing key and an unexpected key when the keys differ only slightly,
+	// e.g. by a typo.
+	ErrRenamedKey = errors.New("renamed key")
+
+	// ErrUnknownPattern is reported for values which look like a misspelled pattern, e.g. "@strin@".
+	ErrUnknownPattern = errors.New("unknown pattern")
+)
+
+var unknownPatternRe = regexp.MustCompile(`^@([A-Za-z_][A-Za-z0-9_]*)(@|\()`)
+
+// builtinPatterns are names of patterns suggested for misspelled patterns if the value matcher can handle them.
+var builtinPatterns = []string{
+	patternString, patternNumber, patternBool, patternArray, patternUUID, patternEmail, patternWildcard,
+	patternDate, patternEmpty, patternIP, patternIPv4, patternIPv6, patternCIDR, patternMAC, patternHostname,
+	patternPort, patternULID, patternKSUID, patternNanoID, patternSnowflake, patternObjectID, patternSemver,
+	patternDuration, patternInterval, patternCurrency, patternCountry, patternLocale, patternIBAN, patternPAN,
+	patternLatitude, patternLongitude, patternGeoJSON, patternHex, patternSHA256, patternSHA1, patternMD5,
+	patternPhone, patternExpr, patternCI, patternTrim,
+}
+
+// suggestPattern returns an error with a suggestion if p looks like a misspelled name of a known pattern.
+func (m *JSONMatcher) suggestPattern(p interface{}) error {
+	name, ok := patternToken(p)
+	if !ok {
+		return nil
+	}
+	if _, ok := closest(name, m.patternNames()); !ok {
+		return nil
+	}
+	return m.errUnknownPattern(name)
+}
+
+// errUnknownPattern returns error of unknown pattern name, with a suggestion if it is close to a known one.
+func (m *JSONMatcher) errUnknownPattern(name string) error {
+	if n, ok := closest(name, m.patternNames()); ok {
+		return fmt.Errorf("%w %q: did you mean %q?", ErrUnknownPattern, "@"+name+"@", "@"+n+"@")
+	}
+	return fmt.Errorf("%w %q", ErrUnknownPattern, "@"+name+"@")
+}
+
+// patternToken returns name of the pattern p looks like, e.g. "strin" for "@strin@" or "inclde" for "@inclde(...)@".
+func patternToken(p interface{}) (string, bool) {
+	ps, ok := p.(string)
+	if !ok {
+		return "", false
+	}
+	match := unknownPatternRe.FindStringSubmatch(ps)
+	if match == nil {
+		return "", false
+	}
+	return match[1], true
+}
+
+// patternNames returns names of built-in patterns the value matcher can handle and names of expanded patterns.
+func (m *JSONMatcher) patternNames() []string {
+	names := []string{patternUnion, patternRef, patternInclude}
+	for _, p := range builtinPatterns {
+		name := strings.Trim(p, "@")
+		if m.valueMatcher.CanMatch("@"+name+"@") || m.valueMatcher.CanMatch("@"+name+"()@") {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// renamedKeys pairs missing keys with unexpected keys they likely are typos of.
+// Both are given in sorted order, the result maps missing keys to unexpected keys.
+func renamedKeys(missing, unexpected []string) map[string]string {
+	pairs := map[string]string{}
+	for _, k := range missing {
+		if u, ok := closest(k, unexpected); ok {
+			pairs[k] = u
+			unexpected = slices.DeleteFunc(slices.Clone(unexpected), func(s string) bool { return s == u })
+		}
+	}
+	return pairs
+}
+
+// closest returns candidate nearest to s if it differs by a typo: at most two edits,
+// each of them on at least three characters of the longer string.
+func closest(s string, candidates []string) (string, bool) {
+	best, bestDist := "", 0
+	for _, c := range candidates {
+		d := editDistance(s, c)
+		if d == 0 || d > 2 || 3*d > max(len([]rune(s)), len([]rune(c))) {
+			continue
+		}
+		if best == "" || d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best, best != ""
+}
+
+// editDistance returns number of insertions, deletions, substitutions and transpositions of adjacent characters
+// needed to change a to b.
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	d := make([][]int, len(ar)+1)
+	for i := range d {
+		d[i] = make([]int, len(br)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ar[i-1] == br[j-2] && ar[i-2] == br[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ar)][len(br)]
+}
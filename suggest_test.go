@@ -0,0 +1,153 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		dist int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"createdAt", "createdAd", 1},
+		{"name", "nmae", 1},
+		{"string", "strin", 1},
+		{"kitten", "sitting", 3},
+		{"čaj", "caj", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.dist, editDistance(tt.a, tt.b))
+			assert.Equal(t, tt.dist, editDistance(tt.b, tt.a))
+		})
+	}
+}
+
+func TestClosest(t *testing.T) {
+	tests := []struct {
+		desc       string
+		s          string
+		candidates []string
+		closest    string
+	}{
+		{"typo", "createdAd", []string{"id", "createdAt", "updatedAt"}, "createdAt"},
+		{"nearest wins", "colour", []string{"colors", "color"}, "color"},
+		{"too short", "id", []string{"ix"}, ""},
+		{"too different", "email", []string{"phone"}, ""},
+		{"identical", "name", []string{"name"}, ""},
+		{"no candidates", "name", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c, ok := closest(tt.s, tt.candidates)
+			assert.Equal(t, tt.closest, c)
+			assert.Equal(t, tt.closest != "", ok)
+		})
+	}
+}
+
+func TestRenamedKeys(t *testing.T) {
+	assert.Equal(t,
+		map[string]string{"createdAd": "createdAt", "nmae": "name"},
+		renamedKeys([]string{"createdAd", "createdAt2", "nmae"}, []string{"createdAt", "name", "zip"}),
+	)
+}
+
+func TestJSONMatcherSuggestions(t *testing.T) {
+	m := NewDefaultJSONMatcher()
+	_ = m.RegisterFragment("user", `{"id": "@number@"}`)
+
+	tests := []struct {
+		desc    string
+		p       string
+		v       string
+		err     error
+		errText string
+		kinds   []MismatchKind
+	}{
+		{
+			"renamed key",
+			`{"createdAd": "@date@", "id": 1}`,
+			`{"createdAt": "2021-01-01", "id": 1}`,
+			ErrRenamedKey,
+			`renamed key "createdAd": did you mean "createdAt"? at ".". expected: "@date@", provided: "2021-01-01"`,
+			[]MismatchKind{KindRenamedKey},
+		},
+		{
+			"renamed key with unbounded object",
+			`{"nmae": "John", "@...@": ""}`,
+			`{"name": "John", "age": 1}`,
+			ErrRenamedKey,
+			`renamed key "nmae": did you mean "name"?`,
+			[]MismatchKind{KindRenamedKey},
+		},
+		{
+			"unrelated keys",
+			`{"email": "@email@"}`,
+			`{"phone": "1"}`,
+			ErrUnexpectedKey,
+			`unexpected key "phone"`,
+			[]MismatchKind{KindPatternMismatch, KindUnexpectedKey},
+		},
+		{
+			"unknown pattern",
+			`{"name": "@strin@"}`,
+			`{"name": "John"}`,
+			ErrUnknownPattern,
+			`unknown pattern "@strin@": did you mean "@string@"? at ".name"`,
+			[]MismatchKind{KindUnknownPattern},
+		},
+		{
+			"unknown pattern with constraint",
+			`{"ip": "@ipv5@.inSubnet('10.0.0.0/8')"}`,
+			`{"ip": "10.0.0.1"}`,
+			ErrUnknownPattern,
+			`unknown pattern "@ipv5@": did you mean "@ipv4@"?`,
+			[]MismatchKind{KindUnknownPattern},
+		},
+		{
+			"unknown function pattern",
+			`{"name": "@inclde('user.json')@"}`,
+			`{"name": "John"}`,
+			ErrUnknownPattern,
+			`unknown pattern "@inclde@": did you mean "@include@"?`,
+			[]MismatchKind{KindUnknownPattern},
+		},
+		{
+			"unknown fragment",
+			`{"owner": "@ref(usr)@"}`,
+			`{"owner": {"id": 1}}`,
+			ErrUnknownFragment,
+			`unknown fragment "usr": did you mean "user"?`,
+			[]MismatchKind{KindFragment},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ok, err := m.Match(tt.p, tt.v)
+			assert.False(t, ok)
+			assert.True(t, errors.Is(err, tt.err), "unexpected error %v", err)
+			assert.Contains(t, err.Error(), tt.errText)
+
+			r, err := m.MatchResult(tt.p, tt.v)
+			assert.Nil(t, err)
+			kinds := []MismatchKind{}
+			for _, mm := range r.Mismatches {
+				kinds = append(kinds, mm.Kind)
+			}
+			assert.Equal(t, tt.kinds, kinds)
+		})
+	}
+
+	ok, err := m.Match(`{"name": "@johndoe@"}`, `{"name": "@johndoe@"}`)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
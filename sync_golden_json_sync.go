@@ -29,23 +29,20 @@ type GoldenJSONSync struct {
 //   - Date patterns (using patternDate)
 //   - Empty patterns (using patternEmpty)
 //   - Wildcard patterns (using patternWildcard)
+//   - Network patterns (IP, CIDR, MAC, hostname and port)
+//   - Identifier patterns (ULID, KSUID, Nano ID, snowflake and ObjectID)
+//   - Semantic version patterns (using patternSemver)
+//   - Duration and interval patterns (using patternDuration and patternInterval)
+//   - Financial and locale patterns (currency, country, locale, IBAN and card number)
+//   - Geo patterns (latitude, longitude and GeoJSON)
+//   - Hex and digest patterns (hex, SHA-256, SHA-1 and MD5)
+//   - Phone number patterns (using patternPhone)
+//   - Expression patterns (using patternExpr)
+//   - Case-insensitive and trimmed string patterns (using patternCI and patternTrim)
 //
 // Returns a pointer to the configured GoldenJSONSync instance.
 func NewGoldenJSONSync() *GoldenJSONSync {
-	return NewGoldenJSON(
-		NewChainMatcher(
-			[]ValueMatcher{
-				NewStringMatcher(patternString),
-				NewNumberMatcher(patternNumber),
-				NewBoolMatcher(patternBool),
-				NewArrayMatcher(patternArray),
-				NewUUIDMatcher(patternUUID),
-				NewEmailMatcher(patternEmail),
-				NewDateMatcher(patternDate),
-				NewEmptyMatcher(patternEmpty),
-				NewWildcardMatcher(patternWildcard),
-			},
-		))
+	return NewGoldenJSON(NewChainMatcher(defaultValueMatchers()))
 }
 
 // NewGoldenJSON creates a new GoldenJSONSync instance with a custom matcher.
@@ -104,6 +101,9 @@ func (g *GoldenJSONSync) Sync(goldenJSON, newJSON string) (string, error) {
 }
 
 func (g *GoldenJSONSync) deepMatch(golden interface{}, actual interface{}) interface{} {
+	if isUnion(golden) || isRef(golden) || isInclude(golden) {
+		return golden
+	}
 	if reflect.TypeOf(golden) != reflect.TypeOf(actual) && !g.valueMatcher.CanMatch(golden) {
 		return actual
 	}
@@ -149,6 +149,10 @@ func (g *GoldenJSONSync) deepMatchMap(golden, actual map[string]interface{}) map
 			results[k] = nil
 			continue
 		}
+		if isAssert(k) {
+			results[k] = goldenVal
+			continue
+		}
 		if actualVal, ok := actual[k]; ok {
 			results[k] = g.deepMatch(goldenVal, actualVal)
 		}
@@ -54,6 +54,18 @@ func TestSyncGoldenJSON(t *testing.T) {
 	"uuid": "@uuid@"
 }`,
 		},
+		{
+			title:  "assertions are preserved",
+			golden: `{"start": 1, "end": 2, "@assert@": ["end > start"]}`,
+			actual: `{"start": 5, "end": 7}`,
+			result: `{"start": 5, "end": 7, "@assert@": "@wildcard@"}`,
+		},
+		{
+			title:  "unions are preserved",
+			golden: `[{"type": "a"}, "@union('type', {\"a\": {\"x\": 2}})@"]`,
+			actual: `[{"type": "b"}, {"type": "a", "x": 1}]`,
+			result: `[{"type": "b"}, {"type": "a", "x": 2}]`,
+		},
 		{
 			title:  "empty golden and new field",
 			golden: `{}`,
@@ -0,0 +1,34 @@
+package gomatch
+
+import (
+	"errors"
+	"regexp"
+)
+
+var ulidRe = regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`)
+
+var ErrNotULID = errors.New("expected ULID")
+
+// A ULIDMatcher matches ULIDs, 26 characters long Crockford's base32 identifiers.
+type ULIDMatcher struct {
+	pattern string
+}
+
+// CanMatch returns true if pattern p can be handled
+func (m *ULIDMatcher) CanMatch(p interface{}) bool {
+	return isPattern(p, m.pattern)
+}
+
+// Match performs value matching against given pattern.
+func (m *ULIDMatcher) Match(p, v interface{}) (bool, error) {
+	s, ok := v.(string)
+	if !ok || !ulidRe.MatchString(s) {
+		return false, ErrNotULID
+	}
+	return true, nil
+}
+
+// NewULIDMatcher creates ULIDMatcher.
+func NewULIDMatcher(pattern string) *ULIDMatcher {
+	return &ULIDMatcher{pattern}
+}
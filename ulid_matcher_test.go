@@ -0,0 +1,72 @@
+package gomatch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var ulidMatcherTests = []struct {
+	desc string
+	v    interface{}
+	ok   bool
+	err  error
+}{
+	{
+		"Should match ULID",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		true,
+		nil,
+	},
+	{
+		"Should match lowercase ULID",
+		"01arz3ndektsv4rrffq69g5fav",
+		true,
+		nil,
+	},
+	{
+		"Should not match ULID with invalid character",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAU",
+		false,
+		ErrNotULID,
+	},
+	{
+		"Should not match ULID overflowing timestamp",
+		"81ARZ3NDEKTSV4RRFFQ69G5FAV",
+		false,
+		ErrNotULID,
+	},
+	{
+		"Should not match short value",
+		"01ARZ3NDEK",
+		false,
+		ErrNotULID,
+	},
+	{
+		"Should not match number",
+		123,
+		false,
+		ErrNotULID,
+	},
+}
+
+func TestULIDMatcher(t *testing.T) {
+	pattern := "@pattern@"
+
+	for _, tt := range ulidMatcherTests {
+		t.Run(tt.desc, func(t *testing.T) {
+			m := NewULIDMatcher(pattern)
+			assert.True(t, m.CanMatch(pattern), "expected to support pattern")
+
+			ok, err := m.Match(pattern, tt.v)
+			if tt.ok {
+				assert.True(t, ok)
+				assert.Nil(t, err)
+			} else {
+				assert.False(t, ok)
+				assert.True(t, errors.Is(err, tt.err))
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package gomatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrUnionDiscriminator  = errors.New("expected union discriminator")
+	ErrUnionUnknownBranch  = errors.New("unknown union branch")
+	ErrUnionBranchMismatch = errors.New("mismatch in union branch")
+)
+
+const patternUnion = "union"
+
+// A unionPattern is a parsed `@union("type", {"card": {...}, "bank": {...}})@` pattern.
+type unionPattern struct {
+	discriminator string
+	branches      map[string]interface{}
+}
+
+// parseUnion parses union pattern p. It returns false if p is not a union pattern.
+func parseUnion(p interface{}) (unionPattern, bool, error) {
+	args, ok := patternFunc(p, patternUnion)
+	if !ok {
+		return unionPattern{}, false, nil
+	}
+	discriminator, rest, err := parseUnionDiscriminator(strings.TrimSpace(args))
+	if err != nil {
+		return unionPattern{}, true, fmt.Errorf("%w %q: %s", ErrInvalidPattern, p, err)
+	}
+	u := unionPattern{discriminator: discriminator}
+	if err := json.Unmarshal([]byte(rest), &u.branches); err != nil || u.branches == nil {
+		return unionPattern{}, true, fmt.Errorf("%w %q: expected JSON object with union branches", ErrInvalidPattern, p)
+	}
+	return u, true, nil
+}
+
+// parseUnionDiscriminator parses the first, optionally quoted, argument of a union pattern.
+// It returns the discriminator and the rest of the arguments after the comma.
+func parseUnionDiscriminator(s string) (string, string, error) {
+	if s == "" {
+		return "", "", errors.New("missing discriminator")
+	}
+	var b strings.Builder
+	i := 0
+	if q := s[0]; q == '"' || q == '\'' {
+		for i = 1; i < len(s) && s[i] != q; i++ {
+			if s[i] == '\\' && i+1 < len(s) {
+				i++
+			}
+			b.WriteByte(s[i])
+		}
+		if i == len(s) {
+			return "", "", errors.New("unterminated discriminator")
+		}
+		i++
+	} else {
+		for ; i < len(s) && s[i] != ','; i++ {
+			b.WriteByte(s[i])
+		}
+	}
+	rest := strings.TrimSpace(s[i:])
+	if !strings.HasPrefix(rest, ",") {
+		return "", "", errors.New("expected ',' after discriminator")
+	}
+	discriminator := strings.TrimSpace(b.String())
+	if discriminator == "" {
+		return "", "", errors.New("missing discriminator")
+	}
+	return discriminator, rest[1:], nil
+}
+
+// names returns sorted names of union branches.
+func (u unionPattern) names() []string {
+	names := make([]string, 0, len(u.branches))
+	for name := range u.branches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// matchUnion selects union branch by the discriminator of actual object and matches the object with it.
+// Object branches do not need to repeat the discriminator, it is added to them.
+func (m *JSONMatcher) matchUnion(s *matchState, u unionPattern, expected, actual interface{}, path []interface{}) error {
+	obj, ok := actual.(map[string]interface{})
+	if !ok {
+		return NewErrGomatch(fmt.Errorf("%w %q in object", ErrUnionDiscriminator, u.discriminator), path, expected, actual, "")
+	}
+	d, ok := obj[u.discriminator]
+	if !ok {
+		return NewErrGomatch(fmt.Errorf("%w %q", ErrUnionDiscriminator, u.discriminator), path, expected, actual, u.discriminator)
+	}
+	name, ok := d.(string)
+	if !ok {
+		name = valueOf(d)
+	}
+	branch, ok := u.branches[name]
+	if !ok {
+		err := fmt.Errorf("%w %q of %q, expected one of %q", ErrUnionUnknownBranch, name, u.discriminator, u.names())
+		return NewErrGomatch(err, appendPath(path, u.discriminator), u.names(), d, u.discriminator)
+	}
+	if b, ok := branch.(map[string]interface{}); ok {
+		if _, ok := b[u.discriminator]; !ok {
+			withDiscriminator := make(map[string]interface{}, len(b)+1)
+			for k, v := range b {
+				withDiscriminator[k] = v
+			}
+			withDiscriminator[u.discriminator] = d
+			branch = withDiscriminator
+		}
+	}
+	if err := m.deepMatch(s, branch, actual, path); err != nil {
+		return NewErrGomatch(unionBranchError{name, u.discriminator, err}, path, branch, actual, "")
+	}
+	return nil
+}
+
+// A unionBranchError wraps errors of the selected union branch.
+type unionBranchError struct {
+	name          string
+	discriminator string
+	err           error
+}
+
+func (e unionBranchError) Error() string {
+	return fmt.Sprintf("%s %q selected by %q: %s", ErrUnionBranchMismatch, e.name, e.discriminator, e.err)
+}
+
+func (e unionBranchError) Unwrap() []error {
+	return []error{ErrUnionBranchMismatch, e.err}
+}
+
+func isUnion(p interface{}) bool {
+	_, ok := patternFunc(p, patternUnion)
+	return ok
+}
@@ -2,35 +2,107 @@ package gomatch
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 )
 
-var ErrNotUUID = errors.New("expected UUID")
+var (
+	ErrNotUUID          = errors.New("expected UUID")
+	ErrUUIDVersion      = errors.New("expected UUID version")
+	ErrUUIDNotCanonical = errors.New("expected UUID in canonical form")
+)
 
-// A UUIDMatcher matches booleans.
+// A UUIDMatcher matches UUIDs.
+//
+// By default it accepts any form supported by uuid.Parse, including braced and urn: forms.
+// It supports optional constraints:
+//
+//	"@uuid@.version(4|7)"  - UUID of one of given versions
+//	"@uuid@.canonical()"   - lowercase hyphenated form, e.g. "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
 type UUIDMatcher struct {
 	pattern string
 }
 
 // CanMatch returns true if pattern p can be handled
 func (m *UUIDMatcher) CanMatch(p interface{}) bool {
-	return isPattern(p, m.pattern)
+	return hasPattern(p, m.pattern)
 }
 
 // Match performs value matching against given pattern.
 func (m *UUIDMatcher) Match(p, v interface{}) (bool, error) {
+	calls, err := patternCalls(p, m.pattern)
+	if err != nil {
+		return false, err
+	}
 	s, ok := v.(string)
 	if !ok {
 		return false, ErrNotUUID
 	}
-	_, err := uuid.Parse(s)
+	id, err := uuid.Parse(s)
 	if err != nil {
 		return false, ErrNotUUID
 	}
+	for _, c := range calls {
+		switch c.name {
+		case "version":
+			if err := matchUUIDVersion(c, id); err != nil {
+				return false, err
+			}
+		case "canonical":
+			if len(c.args) != 0 {
+				return false, errConstraintArgs(c, 0)
+			}
+			if s != id.String() {
+				return false, ErrUUIDNotCanonical
+			}
+		default:
+			return false, errUnknownConstraint(c)
+		}
+	}
 	return true, nil
 }
 
+// ValidatePattern validates constraints of pattern p.
+func (m *UUIDMatcher) ValidatePattern(p string) error {
+	return validatePatternCalls(p, m.pattern, func(c patternCall) error {
+		if c.name != "version" {
+			return validateFlagCall(c, "canonical")
+		}
+		_, err := parseUUIDVersions(c)
+		return err
+	})
+}
+
+func matchUUIDVersion(c patternCall, id uuid.UUID) error {
+	versions, err := parseUUIDVersions(c)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(versions, int(id.Version())) {
+		return nil
+	}
+	return fmt.Errorf("%w %s, got %d", ErrUUIDVersion, strings.Join(splitAlternatives(c.args), "|"), id.Version())
+}
+
+func parseUUIDVersions(c patternCall) ([]int, error) {
+	if len(c.args) == 0 {
+		return nil, errConstraintArgs(c, 1)
+	}
+	versions := []int{}
+	for _, arg := range splitAlternatives(c.args) {
+		version, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid UUID version %q", ErrInvalidPattern, arg)
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
 // NewUUIDMatcher creates UUIDMatcher.
 func NewUUIDMatcher(pattern string) *UUIDMatcher {
 	return &UUIDMatcher{pattern}
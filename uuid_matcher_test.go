@@ -9,39 +9,97 @@ import (
 
 var uuidMatcherTests = []struct {
 	desc string
+	p    string
 	v    interface{}
 	ok   bool
 	err  error
 }{
 	{
 		"Should match UUID",
+		"@pattern@",
 		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
 		true,
 		nil,
 	},
+	{
+		"Should match braced UUID",
+		"@pattern@",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		true,
+		nil,
+	},
 	{
 		"Should not match invalid UUID",
+		"@pattern@",
 		"6ba7b810-9dad-XXXX-80b4-00c04fd430c8",
 		false,
 		ErrNotUUID,
 	},
 	{
 		"Should not match if value is not a string",
+		"@pattern@",
 		123,
 		false,
 		ErrNotUUID,
 	},
+	{
+		"Should match UUID version",
+		"@pattern@.version(4|7)",
+		"0190163d-8694-739b-aea5-966c26f8ad91",
+		true,
+		nil,
+	},
+	{
+		"Should match UUID version given as separate arguments",
+		"@pattern@.version(1, 4)",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		true,
+		nil,
+	},
+	{
+		"Should not match other UUID version",
+		"@pattern@.version(4)",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		false,
+		ErrUUIDVersion,
+	},
+	{
+		"Should fail on invalid UUID version",
+		"@pattern@.version(v4)",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		false,
+		ErrInvalidPattern,
+	},
+	{
+		"Should match canonical UUID",
+		"@pattern@.canonical()",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		true,
+		nil,
+	},
+	{
+		"Should not match urn UUID as canonical",
+		"@pattern@.canonical()",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		false,
+		ErrUUIDNotCanonical,
+	},
+	{
+		"Should not match uppercase UUID as canonical",
+		"@pattern@.canonical()",
+		"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		false,
+		ErrUUIDNotCanonical,
+	},
 }
 
 func TestUUIDMatcher(t *testing.T) {
-	pattern := "@pattern@"
-
 	for _, tt := range uuidMatcherTests {
 		t.Run(tt.desc, func(t *testing.T) {
-			m := NewUUIDMatcher(pattern)
-			assert.True(t, m.CanMatch(pattern), "expected to support pattern")
+			m := NewUUIDMatcher("@pattern@")
+			assert.True(t, m.CanMatch(tt.p), "expected to support pattern")
 
-			ok, err := m.Match(pattern, tt.v)
+			ok, err := m.Match(tt.p, tt.v)
 			if tt.ok {
 				assert.True(t, ok)
 				assert.Nil(t, err)